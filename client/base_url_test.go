@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfig_BaseURL(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		api  string
+		want string
+	}{
+		{"mosapi prod", Config{Environment: ENV_PROD}, API_MOSAPI, MOSAPI_URL},
+		{"mosapi ote", Config{Environment: ENV_OTE}, API_MOSAPI, MOSAPI_OTE_URL},
+		{"rri prod", Config{Environment: ENV_PROD}, API_RRI, RRI_URL},
+		{"rri ote", Config{Environment: ENV_OTE}, API_RRI, RRI_OTE_URL},
+		{"mosapi override", Config{Environment: ENV_PROD, MOSAPIURL: "http://localhost:8080"}, API_MOSAPI, "http://localhost:8080"},
+		{"rri override", Config{Environment: ENV_OTE, MOSAPIURL: "http://mosapi.mock", RRIURL: "http://rri.mock"}, API_RRI, "http://rri.mock"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.BaseURL(tt.api)
+			if err != nil || got != tt.want {
+				t.Fatalf("BaseURL(%q) = %q, %v; want %q", tt.api, got, err, tt.want)
+			}
+		})
+	}
+	if _, err := (Config{}).BaseURL("epp"); !errors.Is(err, ErrUnsupportedAPI) {
+		t.Fatalf("BaseURL(epp) = %v, want ErrUnsupportedAPI", err)
+	}
+}
+
+func TestConfig_ValidateBaseURLOverrides(t *testing.T) {
+	for _, raw := range []string{"mosapi.internal", "ftp://mosapi.internal", "http://"} {
+		cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", Version: V2, Entity: EntityRegistry, Environment: ENV_PROD,
+			RRIURL: raw}
+		if err := cfg.Validate(); !errors.Is(err, ErrInvalidBaseURL) {
+			t.Errorf("Validate() with RRIURL %q = %v, want ErrInvalidBaseURL", raw, err)
+		}
+	}
+}
+
+func TestNewAPIClient_UsesOverrideWithPathPrefix(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := NewAPIClient(API_RRI, Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		MOSAPIURL: "http://mosapi.invalid", RRIURL: srv.URL + "/proxy/rri/"})
+	if err != nil {
+		t.Fatalf("NewAPIClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/report/status", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if gotPath != "/proxy/rri/report/status" {
+		t.Fatalf("path = %q, want /proxy/rri/report/status", gotPath)
+	}
+
+	if _, err := NewAPIClient("epp", Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p"}); !errors.Is(err, ErrUnsupportedAPI) {
+		t.Fatalf("NewAPIClient(epp) = %v, want ErrUnsupportedAPI", err)
+	}
+}
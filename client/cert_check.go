@@ -0,0 +1,148 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+// CertificateStatus summarizes the validity of a client certificate at a point in time.
+type CertificateStatus string
+
+const (
+	CertificateValid        CertificateStatus = "valid"
+	CertificateExpiringSoon CertificateStatus = "expiring_soon"
+	CertificateExpired      CertificateStatus = "expired"
+	CertificateNotYetValid  CertificateStatus = "not_yet_valid"
+)
+
+// DefaultCertExpiryWarning is the default window before expiry in which a certificate is expiring soon.
+const DefaultCertExpiryWarning = 30 * 24 * time.Hour
+
+// CertificateReport describes the TLSA client certificate's leaf.
+type CertificateReport struct {
+	Subject      string            `json:"subject"`
+	Issuer       string            `json:"issuer"`
+	SerialNumber string            `json:"serialNumber"`
+	NotBefore    time.Time         `json:"notBefore"`
+	NotAfter     time.Time         `json:"notAfter"`
+	KeyType      string            `json:"keyType"`
+	Status       CertificateStatus `json:"status"`
+	// DaysRemaining is the number of whole days until NotAfter; negative once expired.
+	DaysRemaining int `json:"daysRemaining"`
+}
+
+// Err returns ErrCertificateExpired, ErrCertificateNotYetValid or
+// ErrCertificateExpiringSoon (wrapped with the relevant date) according to
+// Status, or nil if the certificate is valid.
+func (r *CertificateReport) Err() error {
+	switch r.Status {
+	case CertificateExpired:
+		return fmt.Errorf("%w: %s expired at %s", ErrCertificateExpired, r.Subject, r.NotAfter.Format(time.RFC3339))
+	case CertificateNotYetValid:
+		return fmt.Errorf("%w: %s is valid from %s", ErrCertificateNotYetValid, r.Subject, r.NotBefore.Format(time.RFC3339))
+	case CertificateExpiringSoon:
+		return fmt.Errorf("%w: %s expires at %s (%d days)", ErrCertificateExpiringSoon, r.Subject, r.NotAfter.Format(time.RFC3339), r.DaysRemaining)
+	}
+	return nil
+}
+
+// CertificateCheck enables a preflight of the TLSA client certificate in NewClient.
+// Expired and not yet valid certificates always fail; certificates expiring within
+// ExpiryWarning are reported via OnWarning, or fail if FailOnWarning is set.
+type CertificateCheck struct {
+	// ExpiryWarning is the window before NotAfter in which the certificate is
+	// considered expiring soon. Zero defaults to DefaultCertExpiryWarning (30 days);
+	// a negative value disables the warning.
+	ExpiryWarning time.Duration
+	// FailOnWarning makes NewClient fail for certificates expiring soon.
+	FailOnWarning bool
+	// OnWarning, if set, is called with the report for certificates expiring soon.
+	OnWarning func(*CertificateReport)
+	// Clock provides the current time. Defaults to the system clock.
+	Clock Clock
+}
+
+// preflight inspects leaf and returns an error if the check fails.
+func (cc CertificateCheck) preflight(leaf *x509.Certificate) error {
+	if cc.Clock == nil {
+		cc.Clock = systemClock{}
+	}
+	r := NewCertificateReport(leaf, cc.Clock.Now(), cc.ExpiryWarning)
+	if r.Status == CertificateExpiringSoon && !cc.FailOnWarning {
+		if cc.OnWarning != nil {
+			cc.OnWarning(r)
+		}
+		return nil
+	}
+	return r.Err()
+}
+
+// CheckCertificate loads the TLSA client certificate configured in cfg (PEM
+// strings, files or PKCS#12) and reports its validity at now. Certificates
+// expiring within warnWithin are reported as CertificateExpiringSoon; zero uses
+// DefaultCertExpiryWarning and a negative value never warns. Use the report's Err
+// method to turn the status into an error.
+func CheckCertificate(cfg Config, now time.Time, warnWithin time.Duration) (*CertificateReport, error) {
+	if cfg.AuthType != AUTH_TYPE_TLSA {
+		return nil, ErrNoClientCertificate
+	}
+	if err := cfg.validateTLS(); err != nil {
+		return nil, err
+	}
+	cert, err := cfg.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	leaf := cert.Leaf
+	if leaf == nil {
+		if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return nil, err
+		}
+	}
+	return NewCertificateReport(leaf, now, warnWithin), nil
+}
+
+// NewCertificateReport describes leaf and classifies its validity at now, with the
+// same warnWithin semantics as CheckCertificate.
+func NewCertificateReport(leaf *x509.Certificate, now time.Time, warnWithin time.Duration) *CertificateReport {
+	if warnWithin == 0 {
+		warnWithin = DefaultCertExpiryWarning
+	}
+	r := &CertificateReport{
+		Subject:       leaf.Subject.String(),
+		Issuer:        leaf.Issuer.String(),
+		SerialNumber:  leaf.SerialNumber.String(),
+		NotBefore:     leaf.NotBefore.UTC(),
+		NotAfter:      leaf.NotAfter.UTC(),
+		KeyType:       keyType(leaf.PublicKey),
+		DaysRemaining: int(leaf.NotAfter.Sub(now).Hours() / 24),
+	}
+	switch {
+	case now.After(leaf.NotAfter):
+		r.Status = CertificateExpired
+	case now.Before(leaf.NotBefore):
+		r.Status = CertificateNotYetValid
+	case leaf.NotAfter.Sub(now) <= warnWithin:
+		r.Status = CertificateExpiringSoon
+	default:
+		r.Status = CertificateValid
+	}
+	return r
+}
+
+// keyType describes a public key, e.g. "RSA 2048" or "ECDSA P-256".
+func keyType(pub any) string {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", k.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA " + k.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	}
+	return fmt.Sprintf("%T", pub)
+}
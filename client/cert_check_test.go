@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewCertificateReport_Status(t *testing.T) {
+	cert, _ := newTestCertificate(t, time.Now().Add(10*24*time.Hour))
+	tests := []struct {
+		name    string
+		now     time.Time
+		warn    time.Duration
+		want    CertificateStatus
+		wantErr error
+	}{
+		{"valid", cert.NotBefore.Add(time.Minute), 24 * time.Hour, CertificateValid, nil},
+		{"expiring within default window", cert.NotBefore.Add(time.Minute), 0, CertificateExpiringSoon, ErrCertificateExpiringSoon},
+		{"no warning window", cert.NotAfter.Add(-time.Minute), -1, CertificateValid, nil},
+		{"expired without warning window", cert.NotAfter.Add(time.Second), -1, CertificateExpired, ErrCertificateExpired},
+		{"expired", cert.NotAfter.Add(time.Second), 0, CertificateExpired, ErrCertificateExpired},
+		{"not yet valid", cert.NotBefore.Add(-time.Second), 0, CertificateNotYetValid, ErrCertificateNotYetValid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewCertificateReport(cert, tt.now, tt.warn)
+			if r.Status != tt.want {
+				t.Fatalf("Status = %q, want %q", r.Status, tt.want)
+			}
+			if err := r.Err(); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("Err() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCertificate_Report(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(90*24*time.Hour))
+	cfg := tlsaConfig()
+	cfg.CertificatePEM, cfg.KeyPEM = string(certPEM(cert)), string(keyPEM(t, key))
+
+	r, err := CheckCertificate(cfg, time.Now(), 0)
+	if err != nil {
+		t.Fatalf("CheckCertificate: %v", err)
+	}
+	if r.Status != CertificateValid || r.Subject != "CN=mosapi-client" || r.Issuer != "CN=mosapi-client" || r.KeyType != "ECDSA P-256" {
+		t.Fatalf("report = %+v", r)
+	}
+	if r.DaysRemaining < 89 || r.DaysRemaining > 90 {
+		t.Fatalf("DaysRemaining = %d, want ~90", r.DaysRemaining)
+	}
+
+	if _, err := CheckCertificate(Config{AuthType: AUTH_TYPE_BASIC}, time.Now(), 0); !errors.Is(err, ErrNoClientCertificate) {
+		t.Fatalf("CheckCertificate(basic) = %v, want ErrNoClientCertificate", err)
+	}
+}
+
+func TestNewClient_CertCheckPreflight(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(7*24*time.Hour))
+	cfg := tlsaConfig()
+	cfg.CertificatePEM, cfg.KeyPEM = string(certPEM(cert)), string(keyPEM(t, key))
+
+	var warned *CertificateReport
+	cfg.CertCheck = &CertificateCheck{OnWarning: func(r *CertificateReport) { warned = r }}
+	if _, err := NewClient(cfg); err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if warned == nil || warned.Status != CertificateExpiringSoon {
+		t.Fatalf("OnWarning report = %+v, want expiring soon", warned)
+	}
+
+	cfg.CertCheck = &CertificateCheck{FailOnWarning: true}
+	if _, err := NewClient(cfg); !errors.Is(err, ErrCertificateExpiringSoon) {
+		t.Fatalf("NewClient = %v, want ErrCertificateExpiringSoon", err)
+	}
+
+	cfg.CertCheck = &CertificateCheck{Clock: &fakeClock{now: cert.NotAfter.Add(time.Hour)}}
+	if _, err := NewClient(cfg); !errors.Is(err, ErrCertificateExpired) {
+		t.Fatalf("NewClient = %v, want ErrCertificateExpired", err)
+	}
+}
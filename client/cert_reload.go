@@ -0,0 +1,147 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CertificateReload configures re-reading the TLSA client certificate from
+// CertificateFile/KeyFile or PKCS12File when the files change, so certificates
+// rotated on disk are picked up without restarting the process. The files'
+// modification times and sizes are polled at most once per Interval, during
+// TLS handshakes.
+type CertificateReload struct {
+	// Interval is the minimum time between checks of the files. Defaults to 1 minute.
+	Interval time.Duration
+	// OnLoad, if set, is called with the active certificate's expiry (NotAfter)
+	// when the certificate is first loaded and after every reload.
+	OnLoad func(notAfter time.Time)
+	// OnError, if set, is called when a changed certificate cannot be loaded.
+	// The previous certificate stays active.
+	OnError func(err error)
+	// Clock is used to schedule checks. Defaults to the system clock.
+	Clock Clock
+}
+
+// validate checks that the reload can be applied to cfg's certificate source.
+func (r *CertificateReload) validate(c *Config) error {
+	if r.Interval < 0 {
+		return ErrInvalidCertReload
+	}
+	if c.AuthType != AUTH_TYPE_TLSA {
+		return nil
+	}
+	if c.PKCS12File == "" && (c.CertificateFile == "" || c.KeyFile == "") {
+		return ErrCertReloadRequiresFiles
+	}
+	return nil
+}
+
+// certReloader serves the client certificate to crypto/tls and reloads it
+// when the underlying files change.
+type certReloader struct {
+	cfg      Config
+	files    []string
+	interval time.Duration
+	clock    Clock
+	onLoad   func(time.Time)
+	onError  func(error)
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	stamps    []fileStamp
+	nextCheck time.Time
+}
+
+// fileStamp identifies a version of a file on disk.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func newCertReloader(cfg Config) (*certReloader, error) {
+	rc := *cfg.CertReload
+	r := &certReloader{
+		cfg:      cfg,
+		files:    []string{cfg.PKCS12File},
+		interval: rc.Interval,
+		clock:    rc.Clock,
+		onLoad:   rc.OnLoad,
+		onError:  rc.OnError,
+	}
+	if cfg.PKCS12File == "" {
+		r.files = []string{cfg.CertificateFile, cfg.KeyFile}
+	}
+	if r.interval == 0 {
+		r.interval = time.Minute
+	}
+	if r.clock == nil {
+		r.clock = systemClock{}
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.nextCheck = r.clock.Now().Add(r.interval)
+	return r, nil
+}
+
+// load reads the certificate and records the file stamps it was read from.
+func (r *certReloader) load() error {
+	stamps, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := r.cfg.clientCertificate()
+	if err != nil {
+		return err
+	}
+	if cert.Leaf == nil {
+		if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return err
+		}
+	}
+	r.cert, r.stamps = &cert, stamps
+	if r.onLoad != nil {
+		r.onLoad(cert.Leaf.NotAfter)
+	}
+	return nil
+}
+
+func (r *certReloader) stat() ([]fileStamp, error) {
+	stamps := make([]fileStamp, len(r.files))
+	for i, f := range r.files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		stamps[i] = fileStamp{modTime: fi.ModTime().UTC().Round(0), size: fi.Size()}
+	}
+	return stamps, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (r *certReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock.Now()
+	if now.Before(r.nextCheck) {
+		return r.cert, nil
+	}
+	r.nextCheck = now.Add(r.interval)
+
+	stamps, err := r.stat()
+	if err == nil && slices.Equal(stamps, r.stamps) {
+		return r.cert, nil
+	}
+	if err == nil {
+		err = r.load()
+	}
+	if err != nil && r.onError != nil {
+		r.onError(err)
+	}
+	return r.cert, nil
+}
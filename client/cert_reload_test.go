@@ -0,0 +1,114 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertFiles writes a new certificate expiring at notAfter to certFile and keyFile,
+// stamping both with mtime.
+func writeCertFiles(t *testing.T, certFile, keyFile string, notAfter, mtime time.Time) {
+	t.Helper()
+	cert, key := newTestCertificate(t, notAfter)
+	for path, data := range map[string][]byte{certFile: certPEM(cert), keyFile: keyPEM(t, key)} {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatalf("Chtimes: %v", err)
+		}
+	}
+}
+
+func getClientCertificate(t *testing.T, c *Client) *tls.Certificate {
+	t.Helper()
+	tr, ok := networkTransport(c)
+	if !ok || tr.TLSClientConfig == nil || tr.TLSClientConfig.GetClientCertificate == nil {
+		t.Fatalf("expected GetClientCertificate to be configured")
+	}
+	cert, err := tr.TLSClientConfig.GetClientCertificate(&tls.CertificateRequestInfo{})
+	if err != nil {
+		t.Fatalf("GetClientCertificate: %v", err)
+	}
+	return cert
+}
+
+func TestCertReload_PicksUpRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	first := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+	second := first.Add(30 * 24 * time.Hour)
+	writeCertFiles(t, certFile, keyFile, first, time.Unix(1700000000, 0))
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	var expiries []time.Time
+	cfg := tlsaConfig()
+	cfg.CertificateFile, cfg.KeyFile = certFile, keyFile
+	cfg.CertReload = &CertificateReload{Interval: time.Minute, Clock: clock, OnLoad: func(na time.Time) { expiries = append(expiries, na) }}
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if got := getClientCertificate(t, c).Leaf.NotAfter; !got.Equal(first) {
+		t.Fatalf("NotAfter = %v, want %v", got, first)
+	}
+
+	writeCertFiles(t, certFile, keyFile, second, time.Unix(1700000100, 0))
+	// Not re-checked before the interval elapses
+	if got := getClientCertificate(t, c).Leaf.NotAfter; !got.Equal(first) {
+		t.Fatalf("NotAfter before interval = %v, want %v", got, first)
+	}
+	clock.After(time.Minute)
+	if got := getClientCertificate(t, c).Leaf.NotAfter; !got.Equal(second) {
+		t.Fatalf("NotAfter after rotation = %v, want %v", got, second)
+	}
+	if len(expiries) != 2 || !expiries[0].Equal(first) || !expiries[1].Equal(second) {
+		t.Fatalf("OnLoad expiries = %v, want [%v %v]", expiries, first, second)
+	}
+}
+
+func TestCertReload_KeepsCertificateOnBrokenRotation(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	writeCertFiles(t, certFile, keyFile, notAfter, time.Unix(1700000000, 0))
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	var reloadErr error
+	cfg := tlsaConfig()
+	cfg.CertificateFile, cfg.KeyFile = certFile, keyFile
+	cfg.CertReload = &CertificateReload{Clock: clock, OnError: func(err error) { reloadErr = err }}
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	// Half-written rotation: the key no longer matches
+	if err := os.WriteFile(keyFile, []byte("garbage"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	clock.After(time.Minute)
+	if got := getClientCertificate(t, c).Leaf.NotAfter; !got.Equal(notAfter) {
+		t.Fatalf("NotAfter = %v, want previous certificate %v", got, notAfter)
+	}
+	if reloadErr == nil {
+		t.Fatalf("expected OnError to be called")
+	}
+}
+
+func TestConfig_ValidateCertReload(t *testing.T) {
+	cfg := tlsaConfig()
+	cfg.CertificatePEM, cfg.KeyPEM = "x", "y"
+	cfg.CertReload = &CertificateReload{}
+	if err := cfg.Validate(); !errors.Is(err, ErrCertReloadRequiresFiles) {
+		t.Fatalf("Validate() = %v, want ErrCertReloadRequiresFiles", err)
+	}
+	cfg.CertReload = &CertificateReload{Interval: -time.Second}
+	if err := cfg.Validate(); !errors.Is(err, ErrInvalidCertReload) {
+		t.Fatalf("Validate() = %v, want ErrInvalidCertReload", err)
+	}
+}
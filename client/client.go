@@ -1,14 +1,18 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
-	"bytes"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -18,17 +22,36 @@ type Client struct {
 	// HTTPClient is the underlying HTTP client used for requests.
 	HTTPClient *http.Client
 
-	// baseURL is the root endpoint (differs by environment).
+	// baseURL is the root endpoint (differs by API and environment).
 	baseURL *url.URL
 
 	// cfg is the validated configuration used to construct the client.
 	cfg Config
+
+	// session is set when AuthType is AUTH_TYPE_SESSION.
+	session *sessionTransport
+
+	// transport is the network transport beneath the wrapping round trippers, whose
+	// idle connections Close releases.
+	transport *http.Transport
 }
 
-// NewClient constructs a new Client from the provided Config.
+// NewClient constructs a new MOSAPI Client from the provided Config.
 // It applies sensible defaults, validates the configuration, and configures
-// authentication via either HTTP Basic or TLS client certificate ("TLSA").
-func NewClient(cfg Config) (*Client, error) {
+// authentication via HTTP Basic, TLS client certificate ("TLSA") or a login
+// session cookie ("session").
+func NewClient(cfg Config, opts ...Option) (*Client, error) {
+	return NewAPIClient(API_MOSAPI, cfg, opts...)
+}
+
+// NewAPIClient is like NewClient but targets the given API (API_MOSAPI or API_RRI),
+// using Config.MOSAPIURL or Config.RRIURL if set and the environment's default otherwise.
+func NewAPIClient(api string, cfg Config, opts ...Option) (*Client, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	// Apply defaults if not set
 	if cfg.Version == "" {
 		cfg.Version = V2
@@ -44,10 +67,10 @@ func NewClient(cfg Config) (*Client, error) {
 		return nil, err
 	}
 
-	// Select base URL by environment
-	rawBase := MOSAPI_URL
-	if cfg.Environment == ENV_OTE {
-		rawBase = MOSAPI_OTE_URL
+	// Select base URL by API and environment
+	rawBase, err := cfg.BaseURL(api)
+	if err != nil {
+		return nil, err
 	}
 	u, err := url.Parse(rawBase)
 	if err != nil {
@@ -62,39 +85,167 @@ func NewClient(cfg Config) (*Client, error) {
 		baseTransport = &http.Transport{}
 	}
 
-	var rt http.RoundTripper = baseTransport
+	if err := cfg.configureNetwork(baseTransport); err != nil {
+		return nil, err
+	}
+
+	rootCAs, err := cfg.rootCAs()
+	if err != nil {
+		return nil, err
+	}
+	if rootCAs != nil {
+		if baseTransport.TLSClientConfig == nil {
+			baseTransport.TLSClientConfig = &tls.Config{}
+		}
+		baseTransport.TLSClientConfig.RootCAs = rootCAs
+	}
+
+	c := &Client{
+		baseURL:   u,
+		cfg:       cfg,
+		transport: baseTransport,
+	}
+	// Layer rate limiting directly above the network transport so every attempt consumes a
+	// token, and retries above it so every attempt is authenticated
+	timeout := o.timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	// Bound each attempt rather than the whole call, so backoffs and rate limit waits
+	// above it are not cut short by the deadline
+	var transport http.RoundTripper = &timeoutTransport{timeout: timeout, base: baseTransport}
+	if o.logger != nil {
+		// Log at the network level so session logins and every retry attempt are visible
+		transport = &loggingTransport{logger: o.logger, base: transport}
+	}
+	if cfg.RateLimit != nil && cfg.RateLimit.RequestsPerSecond > 0 {
+		bucket, err := sharedLimiter(cfg, *cfg.RateLimit)
+		if err != nil {
+			return nil, err
+		}
+		transport = &rateLimitTransport{bucket: bucket, base: transport}
+	}
+	if cfg.Retry != nil {
+		transport = newRetryTransport(*cfg.Retry, transport)
+	}
+
+	var rt http.RoundTripper = transport
+	var jar http.CookieJar
 
 	switch cfg.AuthType {
 	case AUTH_TYPE_BASIC:
 		rt = &basicAuthTransport{
 			username: cfg.Username,
 			password: cfg.Password,
-			base:     baseTransport,
+			base:     transport,
 		}
 	case AUTH_TYPE_TLSA:
-		// Configure mutual TLS using provided PEM-encoded certificate and key
-		cert, err := tls.X509KeyPair([]byte(cfg.CertificatePEM), []byte(cfg.KeyPEM))
-		if err != nil {
-			return nil, err
-		}
+		// Configure mutual TLS using the certificate and key from PEM, files or PKCS#12
 		if baseTransport.TLSClientConfig == nil {
 			baseTransport.TLSClientConfig = &tls.Config{}
 		}
 		baseTransport.TLSClientConfig.MinVersion = tls.VersionTLS12
-		baseTransport.TLSClientConfig.Certificates = []tls.Certificate{cert}
-		rt = baseTransport
+		if cfg.CertReload != nil {
+			// Serve the certificate per handshake so rotated files are picked up
+			reloader, err := newCertReloader(cfg)
+			if err != nil {
+				return nil, err
+			}
+			if cfg.CertCheck != nil {
+				if err := cfg.CertCheck.preflight(reloader.cert.Leaf); err != nil {
+					return nil, err
+				}
+			}
+			baseTransport.TLSClientConfig.GetClientCertificate = reloader.GetClientCertificate
+		} else {
+			cert, err := cfg.clientCertificate()
+			if err != nil {
+				return nil, err
+			}
+			if cfg.CertCheck != nil {
+				leaf := cert.Leaf
+				if leaf == nil {
+					if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+						return nil, err
+					}
+				}
+				if err := cfg.CertCheck.preflight(leaf); err != nil {
+					return nil, err
+				}
+			}
+			baseTransport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+		}
+		rt = transport
+	case AUTH_TYPE_SESSION:
+		// Log in once and keep the session cookie in a jar shared with the http.Client
+		jar, err = cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		c.session = &sessionTransport{
+			username:  cfg.Username,
+			password:  cfg.Password,
+			base:      transport,
+			jar:       jar,
+			loginURL:  func() *url.URL { return c.resolve(c.sessionPath("login")) },
+			logoutURL: func() *url.URL { return c.resolve(c.sessionPath("logout")) },
+		}
+		rt = c.session
+	}
+
+	if o.metrics != nil {
+		// Measure whole API calls, inside user middlewares
+		rt = &metricsTransport{metrics: o.metrics, api: api, tld: cfg.EntityID(), base: rt}
+	}
+	rt = o.chain(rt)
+	if o.tracerProvider != nil {
+		// One span per call, covering middlewares, retries and session logins, so
+		// middleware errors and body read limits are recorded
+		rt = newTracingTransport(o, api, cfg, rt)
 	}
 
-	httpClient := &http.Client{
+	c.HTTPClient = &http.Client{
 		Transport: rt,
-		Timeout:   30 * time.Second,
+		Jar:       jar,
 	}
+	return c, nil
+}
+
+// sessionPath returns the MOSAPI session endpoint path (login or logout) for the configured entity.
+func (c *Client) sessionPath(endpoint string) string {
+	return fmt.Sprintf("/%s/%s/%s/%s", c.cfg.Entity, c.cfg.EntityID(), c.cfg.Version, endpoint)
+}
 
-	return &Client{
-		HTTPClient: httpClient,
-		baseURL:    u,
-		cfg:        cfg,
-	}, nil
+// resolve resolves a relative path against the client's base URL.
+func (c *Client) resolve(path string) *url.URL {
+	return c.resolveReference(&url.URL{Path: path})
+}
+
+// resolveReference resolves ref against the client's base URL. Absolute paths
+// are kept below the base URL's path, so a base URL such as
+// https://proxy.internal/mosapi can prefix every request.
+func (c *Client) resolveReference(ref *url.URL) *url.URL {
+	if prefix := strings.TrimSuffix(c.baseURL.Path, "/"); prefix != "" && strings.HasPrefix(ref.Path, "/") {
+		r := *ref
+		r.Path = prefix + ref.Path
+		ref = &r
+	}
+	return c.baseURL.ResolveReference(ref)
+}
+
+// Close releases resources held by the client. With AUTH_TYPE_SESSION it logs
+// out of the MOSAPI session; it is a no-op for the other auth types apart from
+// closing idle connections.
+func (c *Client) Close() error {
+	var err error
+	if c.session != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		err = c.session.logout(ctx)
+	}
+	// The wrapping round trippers do not forward CloseIdleConnections
+	c.transport.CloseIdleConnections()
+	return err
 }
 
 // NewRequest builds an HTTP request relative to the client's base URL.
@@ -108,7 +259,7 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body io.Re
 	if p.IsAbs() {
 		fullURL = p
 	} else {
-		fullURL = c.baseURL.ResolveReference(p)
+		fullURL = c.resolveReference(p)
 	}
 	req, err := http.NewRequestWithContext(ctx, method, fullURL.String(), body)
 	if err != nil {
@@ -138,7 +289,7 @@ func (c *Client) Config() Config { return c.cfg }
 // DoJSON issues an HTTP request with optional JSON body and decodes a JSON response into out.
 // If in is non-nil, it will be JSON-encoded and sent with Content-Type: application/json.
 // If out is non-nil and the response has a JSON Content-Type, it will be decoded.
-// Returns a *HTTPError for non-2xx responses.
+// Returns a *HTTPError (or *RateLimitError for 429) for non-2xx responses.
 func (c *Client) DoJSON(ctx context.Context, method, path string, in any, out any) (*http.Response, error) {
 	var body io.Reader
 	if in != nil {
@@ -161,10 +312,11 @@ func (c *Client) DoJSON(ctx context.Context, method, path string, in any, out an
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		httpErr := NewHTTPError(req, resp)
 		// Drain and close body to allow connection reuse
 		io.Copy(io.Discard, resp.Body)
 		resp.Body.Close()
-		return resp, &HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return resp, httpErr
 	}
 	if out != nil {
 		// Best-effort JSON decode; handle empty body
@@ -1,10 +1,18 @@
 package client
 
-import "slices"
+import (
+	"net/url"
+	"slices"
+	"strconv"
+)
 
 type Config struct {
 	// TLD is the top-level domain for which the MOSAPI client is being configured.
+	// It is required when Entity is EntityRegistry.
 	TLD string
+	// IANAID is the IANA ID of the registrar for which the MOSAPI client is being configured.
+	// It is required when Entity is EntityRegistrar.
+	IANAID int
 	// AuthType is the type of authentication to use with the MOSAPI API. This should be one of validAuthTypes.
 	AuthType string
 	// CertificatePEM is the PEM-encoded certificate used for TLS client authentication when AuthType is AUTH_TYPE_TLSA.
@@ -13,9 +21,29 @@ type Config struct {
 	// KeyPEM is the PEM-encoded private key used for TLS client authentication when AuthType is AUTH_TYPE_TLSA.
 	// It is required in that case.
 	KeyPEM string
-	// Username is the username to use for authentication when AuthType is AUTH_TYPE_BASIC and is required in that case.
+	// CertificateFile and KeyFile are paths to PEM files used instead of CertificatePEM and KeyPEM.
+	CertificateFile string
+	KeyFile         string
+	// KeyPassphrase decrypts a passphrase-protected PEM private key (PKCS#8 or legacy encrypted PEM).
+	KeyPassphrase string
+	// PKCS12File is the path to a PKCS#12 (.p12/.pfx) bundle holding the client certificate and key,
+	// used instead of the PEM options. PKCS12Password is its password.
+	PKCS12File     string
+	PKCS12Password string
+	// CAPEM or CAFile provide a custom CA pool used to verify the server instead of the system roots.
+	CAPEM  string
+	CAFile string
+	// CertReload re-reads the TLSA certificate files when they change. It requires
+	// CertificateFile and KeyFile, or PKCS12File. Nil loads the certificate once.
+	CertReload *CertificateReload
+	// CertCheck inspects the TLSA certificate in NewClient and fails on expired or not yet valid
+	// certificates, warning (or failing) when it expires soon. Nil skips the check.
+	CertCheck *CertificateCheck
+	// Username is the username to use for authentication when AuthType is AUTH_TYPE_BASIC or AUTH_TYPE_SESSION
+	// and is required in that case.
 	Username string
-	// Password is the password to use for authentication when AuthType is AUTH_TYPE_BASIC and is required in that case.
+	// Password is the password to use for authentication when AuthType is AUTH_TYPE_BASIC or AUTH_TYPE_SESSION
+	// and is required in that case.
 	Password string
 	// Version is the version of the MOSAPI API to use. It defaults to "v2".
 	Version string
@@ -23,6 +51,30 @@ type Config struct {
 	Entity string
 	// Environment is the environment for which the MOSAPI client is being configured. This should be one of validEnvs.
 	Environment string
+	// MOSAPIURL and RRIURL override the base URLs of the MOSAPI and RRI endpoints, e.g. to
+	// go through an internal proxy or a local mock. Empty uses the Environment's default.
+	MOSAPIURL string
+	RRIURL    string
+	// ProxyURL routes requests through an explicit proxy instead of the environment's
+	// proxy settings: http:// or https:// for HTTP CONNECT proxies, socks5:// or socks5h://
+	// for SOCKS5. Credentials may be embedded in the URL or set via ProxyUsername/ProxyPassword;
+	// ProxyPassword requires a username, from ProxyUsername or the URL.
+	ProxyURL      string
+	ProxyUsername string
+	ProxyPassword string
+	// SourceIP binds outgoing connections to a local address, e.g. an allowlisted egress IP.
+	SourceIP string
+	// DialContext, if set, dials all outgoing connections instead of the default dialer.
+	// It cannot be combined with SourceIP.
+	DialContext DialContextFunc
+	// Retry enables retries of idempotent requests on transport errors and retryable status codes.
+	// Nil disables retries.
+	Retry *RetryPolicy
+	// RateLimit enables client-side throttling shared by all clients built from the same credentials.
+	// Nil disables rate limiting. The bucket is created by the first client built for a set of
+	// credentials and kept for the life of the process; later clients with the same credentials
+	// must use the same rate, burst and clock or fail with ErrRateLimitMismatch.
+	RateLimit *RateLimit
 }
 
 func (c *Config) Validate() error {
@@ -32,25 +84,39 @@ func (c *Config) Validate() error {
 	if !slices.Contains(validVersions, c.Version) {
 		return ErrUnsupportedVersion
 	}
-	if c.TLD == "" {
+	if !slices.Contains(validEntities, c.Entity) {
+		return ErrUnsupportedEntity
+	}
+	if c.Entity == EntityRegistry && c.TLD == "" {
 		return ErrTLDRequired
 	}
+	if c.Entity == EntityRegistrar && c.IANAID <= 0 {
+		return ErrIANAIDRequired
+	}
+	for _, raw := range []string{c.MOSAPIURL, c.RRIURL} {
+		if raw == "" {
+			continue
+		}
+		if u, err := url.Parse(raw); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidBaseURL
+		}
+	}
+	if err := c.validateNetwork(); err != nil {
+		return err
+	}
 	if !slices.Contains(validAuthTypes, c.AuthType) {
 		return ErrInvalidAuthType
 	}
-	if !slices.Contains(validEntities, c.Entity) {
-		return ErrUnsupportedEntity
-	}
 
-	if c.AuthType == AUTH_TYPE_TLSA {
-		if c.CertificatePEM == "" {
-			return ErrCertRequired
-		}
-		if c.KeyPEM == "" {
-			return ErrKeyRequired
+	if err := c.validateTLS(); err != nil {
+		return err
+	}
+	if c.CertReload != nil {
+		if err := c.CertReload.validate(c); err != nil {
+			return err
 		}
 	}
-	if c.AuthType == AUTH_TYPE_BASIC {
+	if c.AuthType == AUTH_TYPE_BASIC || c.AuthType == AUTH_TYPE_SESSION {
 		if c.Username == "" {
 			return ErrUsernameRequired
 		}
@@ -58,6 +124,62 @@ func (c *Config) Validate() error {
 			return ErrPasswordRequired
 		}
 	}
+	if c.Retry != nil {
+		if err := c.Retry.validate(); err != nil {
+			return err
+		}
+	}
+	if c.RateLimit != nil {
+		if err := c.RateLimit.validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// EntityID returns the identifier used in MOSAPI paths: the TLD for registries
+// and the IANA ID for registrars.
+func (c Config) EntityID() string {
+	if c.Entity == EntityRegistrar {
+		return strconv.Itoa(c.IANAID)
+	}
+	return c.TLD
+}
+
+// BaseURL returns the base URL for api (API_MOSAPI or API_RRI): the configured
+// override if set, otherwise the default for the Environment.
+func (c Config) BaseURL(api string) (string, error) {
+	defaults, ok := defaultBaseURLs[api]
+	if !ok {
+		return "", ErrUnsupportedAPI
+	}
+	switch {
+	case api == API_MOSAPI && c.MOSAPIURL != "":
+		return c.MOSAPIURL, nil
+	case api == API_RRI && c.RRIURL != "":
+		return c.RRIURL, nil
+	}
+	return defaults[c.Environment], nil
+}
+
+// ValidateService reports whether service is one of the monitored MOSAPI
+// services (ServiceDNS, ServiceDNSSEC, ServiceEPP, ServiceRDDS, ServiceRDAP).
+func ValidateService(service string) error {
+	if !slices.Contains(validServices, service) {
+		return ErrUnsupportedService
+	}
+	return nil
+}
+
+// ValidateEntityService is like ValidateService but additionally restricts registrars
+// to the services monitored for them (ServiceRDDS, ServiceRDAP).
+func ValidateEntityService(entity, service string) error {
+	if err := ValidateService(service); err != nil {
+		return err
+	}
+	if entity == EntityRegistrar && !slices.Contains(validRegistrarServices, service) {
+		return ErrRegistrarService
+	}
+	return nil
+}
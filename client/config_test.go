@@ -75,6 +75,32 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: ErrTLDRequired,
 		},
+		{
+			name: "valid registrar config",
+			config: Config{
+				IANAID:      9999,
+				AuthType:    AUTH_TYPE_BASIC,
+				Username:    "user",
+				Password:    "pass",
+				Version:     "v2",
+				Entity:      "rr",
+				Environment: "prod",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "missing IANA ID for registrar",
+			config: Config{
+				TLD:         "example.com",
+				AuthType:    AUTH_TYPE_BASIC,
+				Username:    "user",
+				Password:    "pass",
+				Version:     "v2",
+				Entity:      "rr",
+				Environment: "prod",
+			},
+			wantErr: ErrIANAIDRequired,
+		},
 		{
 			name: "invalid auth type",
 			config: Config{
@@ -145,3 +171,47 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateService(t *testing.T) {
+	for _, s := range []string{ServiceDNS, ServiceDNSSEC, ServiceEPP, ServiceRDDS, ServiceRDAP} {
+		if err := ValidateService(s); err != nil {
+			t.Errorf("ValidateService(%q) = %v, want nil", s, err)
+		}
+	}
+	for _, s := range []string{"", "dns", "FTP"} {
+		if err := ValidateService(s); err != ErrUnsupportedService {
+			t.Errorf("ValidateService(%q) = %v, want ErrUnsupportedService", s, err)
+		}
+	}
+}
+
+func TestValidateEntityService(t *testing.T) {
+	tests := []struct {
+		entity  string
+		service string
+		wantErr error
+	}{
+		{EntityRegistry, ServiceDNS, nil},
+		{EntityRegistry, ServiceRDAP, nil},
+		{EntityRegistrar, ServiceRDDS, nil},
+		{EntityRegistrar, ServiceRDAP, nil},
+		{EntityRegistrar, ServiceDNS, ErrRegistrarService},
+		{EntityRegistrar, "FTP", ErrUnsupportedService},
+	}
+	for _, tt := range tests {
+		if err := ValidateEntityService(tt.entity, tt.service); err != tt.wantErr {
+			t.Errorf("ValidateEntityService(%q, %q) = %v, want %v", tt.entity, tt.service, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConfig_EntityID(t *testing.T) {
+	ry := Config{Entity: EntityRegistry, TLD: "example"}
+	if got := ry.EntityID(); got != "example" {
+		t.Errorf("registry EntityID = %q, want example", got)
+	}
+	rr := Config{Entity: EntityRegistrar, IANAID: 9999}
+	if got := rr.EntityID(); got != "9999" {
+		t.Errorf("registrar EntityID = %q, want 9999", got)
+	}
+}
@@ -13,13 +13,24 @@ const (
 	// AUTH_TYPE_BASIC is the auth type for basic authentication
 	AUTH_TYPE_BASIC = "basic"
 
+	// AUTH_TYPE_SESSION is the auth type for session based authentication: the client logs in
+	// once with username/password and reuses the session cookie until Close logs out
+	AUTH_TYPE_SESSION = "session"
+
 	MOSAPI_URL     = "https://mosapi.icann.org"
 	MOSAPI_OTE_URL = "https://mosapi-ote.icann.org"
+	RRI_URL        = "https://ry-api.icann.org"
+	RRI_OTE_URL    = "https://ry-api-ote.icann.org"
+
+	// API_MOSAPI and API_RRI select the ICANN API a client talks to (see NewAPIClient)
+	API_MOSAPI = "mosapi"
+	API_RRI    = "rri"
 
 	ServiceEPP    = "EPP"
 	ServiceDNS    = "DNS"
 	ServiceDNSSEC = "DNSSEC"
 	ServiceRDDS   = "RDDS"
+	ServiceRDAP   = "RDAP"
 
 	EntityRegistry  = "ry"
 	EntityRegistrar = "rr"
@@ -32,14 +43,23 @@ var (
 	validEnvs = []string{ENV_PROD, ENV_OTE}
 
 	// validAuthTypes is a list of valid authentication types we accept
-	validAuthTypes = []string{AUTH_TYPE_TLSA, AUTH_TYPE_BASIC}
+	validAuthTypes = []string{AUTH_TYPE_TLSA, AUTH_TYPE_BASIC, AUTH_TYPE_SESSION}
 
 	// validServices is a list of valid services we accept
-	validServices = []string{ServiceEPP, ServiceDNS, ServiceDNSSEC, ServiceRDDS}
+	validServices = []string{ServiceEPP, ServiceDNS, ServiceDNSSEC, ServiceRDDS, ServiceRDAP}
+
+	// validRegistrarServices is the subset of validServices monitored for registrars
+	validRegistrarServices = []string{ServiceRDDS, ServiceRDAP}
 
 	// validEntities is a list of valid entities we accept
 	validEntities = []string{EntityRegistry, EntityRegistrar}
 
 	// validVersions is a list of valid versions we accept
 	validVersions = []string{V2}
+
+	// defaultBaseURLs maps each API to its base URL per environment
+	defaultBaseURLs = map[string]map[string]string{
+		API_MOSAPI: {ENV_PROD: MOSAPI_URL, ENV_OTE: MOSAPI_OTE_URL},
+		API_RRI:    {ENV_PROD: RRI_URL, ENV_OTE: RRI_OTE_URL},
+	}
 )
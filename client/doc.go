@@ -1,7 +1,8 @@
 // Package client provides a shared HTTP client and authentication wiring for
 // ICANN APIs (MOSAPI and RRI). It centralizes:
 //   - Environment-aware base URLs (prod/ote)
-//   - Auth transports: BASIC (username/password) and TLS client cert (TLSA)
+//   - Auth transports: BASIC (username/password), TLS client cert (TLSA) and
+//     login sessions (SESSION) that reuse a cookie until Client.Close logs out
 //   - Request helpers for composing service-specific relative paths
 //
 // Service packages (mosapi, rri) compose this base client to expose higher-level
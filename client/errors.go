@@ -3,17 +3,47 @@ package client
 import "fmt"
 
 var (
-	ErrEnvRequired        = fmt.Errorf("environment is required")
-	ErrInvalidEnv         = fmt.Errorf("invalid environment only %v are supported", validEnvs)
-	ErrInvalidAuthType    = fmt.Errorf("invalid authType only %v are supported", validAuthTypes)
-	ErrNilHTTPClient      = fmt.Errorf("http client cannot be nil")
-	ErrTLDRequired        = fmt.Errorf("TLD is required")
-	ErrAuthTypeRequired   = fmt.Errorf("authType is required")
-	ErrCertRequired       = fmt.Errorf("certificate PEM is required when AuthType is TLSA")
-	ErrKeyRequired        = fmt.Errorf("key PEM is required when AuthType is TLSA")
-	ErrUsernameRequired   = fmt.Errorf("username is required when AuthType is basic")
-	ErrPasswordRequired   = fmt.Errorf("password is required when AuthType is basic")
-	ErrUnsupportedVersion = fmt.Errorf("unsupported version only %v are supported", validVersions)
-	ErrUnsupportedEntity  = fmt.Errorf("unsupported entity only %v are supported", validEntities)
-	ErrUnsupportedService = fmt.Errorf("unsupported service only %v are supported", validServices)
+	ErrEnvRequired             = fmt.Errorf("environment is required")
+	ErrInvalidEnv              = fmt.Errorf("invalid environment only %v are supported", validEnvs)
+	ErrInvalidAuthType         = fmt.Errorf("invalid authType only %v are supported", validAuthTypes)
+	ErrNilHTTPClient           = fmt.Errorf("http client cannot be nil")
+	ErrTLDRequired             = fmt.Errorf("TLD is required")
+	ErrIANAIDRequired          = fmt.Errorf("IANA ID is required when Entity is rr")
+	ErrAuthTypeRequired        = fmt.Errorf("authType is required")
+	ErrCertRequired            = fmt.Errorf("certificate PEM, certificate file or PKCS#12 file is required when AuthType is TLSA")
+	ErrKeyRequired             = fmt.Errorf("key PEM or key file is required when AuthType is TLSA")
+	ErrConflictingCertSources  = fmt.Errorf("set only one of certificate PEM, certificate file or PKCS#12 file (and one of key PEM or key file)")
+	ErrConflictingCASources    = fmt.Errorf("set only one of CA PEM or CA file")
+	ErrTLSFileUnreadable       = fmt.Errorf("TLS file is not readable")
+	ErrKeyPassphraseRequired   = fmt.Errorf("key passphrase is required for an encrypted private key")
+	ErrInvalidCA               = fmt.Errorf("no valid certificates found in CA PEM")
+	ErrInvalidCertReload       = fmt.Errorf("invalid certificate reload: interval must not be negative")
+	ErrCertReloadRequiresFiles = fmt.Errorf("certificate reload requires CertificateFile and KeyFile, or PKCS12File")
+	ErrNoClientCertificate     = fmt.Errorf("no client certificate configured: AuthType is not TLSA")
+	ErrCertificateExpired      = fmt.Errorf("client certificate expired")
+	ErrCertificateNotYetValid  = fmt.Errorf("client certificate not yet valid")
+	ErrCertificateExpiringSoon = fmt.Errorf("client certificate expiring soon")
+	ErrUsernameRequired        = fmt.Errorf("username is required when AuthType is basic or session")
+	ErrPasswordRequired        = fmt.Errorf("password is required when AuthType is basic or session")
+	ErrUnsupportedVersion      = fmt.Errorf("unsupported version only %v are supported", validVersions)
+	ErrUnsupportedEntity       = fmt.Errorf("unsupported entity only %v are supported", validEntities)
+	ErrUnsupportedService      = fmt.Errorf("unsupported service only %v are supported", validServices)
+	ErrInvalidRetryPolicy      = fmt.Errorf("invalid retry policy: attempts and backoffs must not be negative and jitter must be between 0 and 1")
+	ErrInvalidRateLimit        = fmt.Errorf("invalid rate limit: requests per second and burst must not be negative")
+	ErrRateLimited             = fmt.Errorf("rate limited by server")
+	ErrRateLimitMismatch       = fmt.Errorf("rate limit differs from the one shared by clients with the same credentials")
+	ErrNotFound                = fmt.Errorf("resource not found")
+	ErrUnauthorized            = fmt.Errorf("unauthorized")
+	ErrForbidden               = fmt.Errorf("forbidden")
+	ErrServerError             = fmt.Errorf("server error")
+	ErrUnsupportedAPI          = fmt.Errorf("unsupported API only %s and %s are supported", API_MOSAPI, API_RRI)
+	ErrInvalidBaseURL          = fmt.Errorf("invalid base URL: must be an absolute http or https URL")
+	ErrInvalidProxyURL         = fmt.Errorf("invalid proxy URL: must be an http, https, socks5 or socks5h URL with a host")
+	ErrProxyURLRequired        = fmt.Errorf("proxy URL is required when proxy username or password is set")
+	ErrProxyUsernameRequired   = fmt.Errorf("proxy username is required when proxy password is set")
+	ErrInvalidSourceIP         = fmt.Errorf("invalid source IP address")
+	ErrConflictingDialer       = fmt.Errorf("set only one of source IP or custom dialer")
+	ErrResponseTooLarge        = fmt.Errorf("response body exceeds the configured size limit")
+	ErrNilRegisterer           = fmt.Errorf("metrics registerer is required")
+	ErrRegistrarService        = fmt.Errorf("unsupported service for registrars only %v are supported", validRegistrarServices)
 )
@@ -1,15 +1,106 @@
 package client
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxErrorBody bounds how much of an error response body is kept on HTTPError.
+const maxErrorBody = 4 << 10
 
 // HTTPError represents a non-2xx HTTP response.
-// It includes the status code, method, and URL for programmatic inspection.
+// It includes the status code, method, and URL for programmatic inspection,
+// a bounded prefix of the response body and, when the body is a MOSAPI error
+// document, its result code, message and description.
+//
+// Use errors.Is with ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited
+// or ErrServerError to classify the error.
 type HTTPError struct {
 	StatusCode int
 	Method     string
 	URL        string
+
+	// Body holds up to the first 4 KiB of the response body.
+	Body []byte
+	// ResultCode, Message and Description are parsed from a MOSAPI error document, if any.
+	ResultCode  int
+	Message     string
+	Description string
 }
 
 func (e *HTTPError) Error() string {
-	return fmt.Sprintf("http error: %d %s %s", e.StatusCode, e.Method, e.URL)
+	msg := fmt.Sprintf("http error: %d %s %s", e.StatusCode, e.Method, e.URL)
+	switch {
+	case e.Message != "" && e.ResultCode != 0:
+		msg += fmt.Sprintf(": %s (result code %d)", e.Message, e.ResultCode)
+	case e.Message != "":
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the status code matches one of the sentinel errors.
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	}
+	return false
+}
+
+// RateLimitError is returned when the server rejected a request with
+// 429 Too Many Requests. It matches ErrRateLimited with errors.Is and
+// unwraps to the underlying *HTTPError.
+type RateLimitError struct {
+	*HTTPError
+	// RetryAfter is the delay requested by the server via Retry-After; zero if absent.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: rate limited, retry after %s", e.HTTPError.Error(), e.RetryAfter)
+	}
+	return fmt.Sprintf("%s: rate limited", e.HTTPError.Error())
+}
+
+func (e *RateLimitError) Is(target error) bool { return target == ErrRateLimited }
+
+func (e *RateLimitError) Unwrap() error { return e.HTTPError }
+
+// errorDocument is the JSON error body returned by MOSAPI.
+type errorDocument struct {
+	ResultCode  int    `json:"resultCode"`
+	Message     string `json:"message"`
+	Description string `json:"description"`
+}
+
+// NewHTTPError returns the error for a non-2xx response to req: a *RateLimitError
+// for 429 Too Many Requests and a *HTTPError otherwise. It reads up to 4 KiB of
+// the response body; the caller remains responsible for closing it.
+func NewHTTPError(req *http.Request, resp *http.Response) error {
+	he := &HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+	if resp.Body != nil {
+		he.Body, _ = io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		var doc errorDocument
+		if json.Unmarshal(he.Body, &doc) == nil {
+			he.ResultCode, he.Message, he.Description = doc.ResultCode, doc.Message, doc.Description
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		ra, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		return &RateLimitError{HTTPError: he, RetryAfter: ra}
+	}
+	return he
 }
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewHTTPError_ParsesMOSAPIErrorDocument(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"resultCode": 2012, "message": "TLD not found", "description": "The TLD in the request does not exist"}`))
+	}))
+	defer srv.Close()
+	c := newRateLimitTestClient(t, Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p"}, srv.URL)
+
+	_, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	var he *HTTPError
+	if !errors.As(err, &he) {
+		t.Fatalf("err = %v, want *HTTPError", err)
+	}
+	if he.ResultCode != 2012 || he.Message != "TLD not found" || he.Description != "The TLD in the request does not exist" {
+		t.Fatalf("parsed = %d %q %q", he.ResultCode, he.Message, he.Description)
+	}
+	if !strings.Contains(string(he.Body), "TLD not found") {
+		t.Fatalf("Body = %q", he.Body)
+	}
+	if !strings.HasPrefix(err.Error(), "http error: 404 GET ") || !strings.HasSuffix(err.Error(), ": TLD not found (result code 2012)") {
+		t.Fatalf("Error() = %q", err.Error())
+	}
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrServerError) {
+		t.Fatalf("errors.Is mismatch for %v", err)
+	}
+}
+
+func TestNewHTTPError_BodyIsBounded(t *testing.T) {
+	big := strings.Repeat("x", 3*maxErrorBody)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(big))
+	}))
+	defer srv.Close()
+	c := newRateLimitTestClient(t, Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p"}, srv.URL)
+
+	_, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	var he *HTTPError
+	if !errors.As(err, &he) || len(he.Body) != maxErrorBody || he.Message != "" {
+		t.Fatalf("err = %v, body len = %d, want %d without message", err, len(he.Body), maxErrorBody)
+	}
+}
+
+func TestHTTPError_IsSentinels(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrServerError}
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServerError},
+		{http.StatusServiceUnavailable, ErrServerError},
+		{http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		err := &HTTPError{StatusCode: tt.status}
+		for _, s := range sentinels {
+			if got := errors.Is(err, s); got != (s == tt.want) {
+				t.Errorf("errors.Is(%d, %v) = %v", tt.status, s, got)
+			}
+		}
+	}
+}
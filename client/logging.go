@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LevelTrace is the log level at which request and response headers are logged,
+// below slog.LevelDebug used for per-request summaries.
+const LevelTrace = slog.LevelDebug - 4
+
+// redacted replaces secrets in logs.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are never logged in clear text.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// WithLogger logs every HTTP attempt (including session logins and retries) to
+// logger: method, path, status, latency, attempt number and response size at
+// debug level, and redacted headers at LevelTrace. Authorization headers and
+// cookies are always redacted. The summary of a response is logged when its body
+// is closed; response_bytes counts the bytes read from the body until then.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) { o.logger = logger }
+}
+
+// attemptKey carries the attempt number of a retried request in its context.
+type attemptKey struct{}
+
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+func attemptFrom(ctx context.Context) int {
+	if n, ok := ctx.Value(attemptKey{}).(int); ok {
+		return n
+	}
+	return 1
+}
+
+// loggingTransport logs each request sent over the network.
+type loggingTransport struct {
+	logger *slog.Logger
+	base   http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !t.logger.Enabled(ctx, slog.LevelDebug) {
+		return t.base.RoundTrip(req)
+	}
+	trace := t.logger.Enabled(ctx, LevelTrace)
+	if trace {
+		t.logger.LogAttrs(ctx, LevelTrace, "http request",
+			slog.String("method", req.Method),
+			slog.String("url", req.URL.Redacted()),
+			slog.Any("headers", redactHeaders(req.Header)))
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("host", req.URL.Host),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", time.Since(start)),
+		slog.Int("attempt", attemptFrom(ctx)),
+	}
+	if err != nil {
+		t.logger.LogAttrs(ctx, slog.LevelDebug, "http request failed", append(attrs, slog.String("error", err.Error()))...)
+		return nil, err
+	}
+	if trace {
+		t.logger.LogAttrs(ctx, LevelTrace, "http response",
+			slog.Int("status", resp.StatusCode),
+			slog.Any("headers", redactHeaders(resp.Header)))
+	}
+	// Content-Length is -1 for chunked responses, so the summary is logged once the
+	// body is closed, with the number of bytes actually read
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	resp.Body = &loggedBody{ReadCloser: resp.Body, log: func(n int64) {
+		t.logger.LogAttrs(ctx, slog.LevelDebug, "http request", append(attrs, slog.Int64("response_bytes", n))...)
+	}}
+	return resp, nil
+}
+
+// loggedBody counts the bytes read from a response body and logs them on Close.
+type loggedBody struct {
+	io.ReadCloser
+	n    int64
+	once sync.Once
+	log  func(n int64)
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.log(b.n) })
+	return err
+}
+
+// redactHeaders returns h as a log group with sensitive headers redacted.
+func redactHeaders(h http.Header) slog.Value {
+	attrs := make([]slog.Attr, 0, len(h))
+	for _, name := range slices.Sorted(maps.Keys(h)) {
+		v := strings.Join(h[name], ", ")
+		for _, s := range sensitiveHeaders {
+			if strings.EqualFold(name, s) {
+				v = redacted
+			}
+		}
+		attrs = append(attrs, slog.String(name, v))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer so a Config can be logged without leaking
+// passwords, passphrases or PEM material.
+func (c Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("environment", c.Environment),
+		slog.String("entity", c.Entity),
+		slog.String("entity_id", c.EntityID()),
+		slog.String("version", c.Version),
+		slog.String("auth_type", c.AuthType),
+		slog.String("username", c.Username),
+		slog.String("password", redactSecret(c.Password)),
+		slog.String("certificate_pem", redactSecret(c.CertificatePEM)),
+		slog.String("key_pem", redactSecret(c.KeyPEM)),
+		slog.String("certificate_file", c.CertificateFile),
+		slog.String("key_file", c.KeyFile),
+		slog.String("key_passphrase", redactSecret(c.KeyPassphrase)),
+		slog.String("pkcs12_file", c.PKCS12File),
+		slog.String("pkcs12_password", redactSecret(c.PKCS12Password)),
+		slog.String("mosapi_url", c.MOSAPIURL),
+		slog.String("rri_url", c.RRIURL),
+		slog.String("proxy_url", redactURL(c.ProxyURL)),
+		slog.String("proxy_username", c.ProxyUsername),
+		slog.String("proxy_password", redactSecret(c.ProxyPassword)),
+		slog.String("source_ip", c.SourceIP),
+	)
+}
+
+// redactURL hides a password embedded in raw.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redactSecret(raw)
+	}
+	return u.Redacted()
+}
+
+// redactSecret returns redacted for non-empty secrets.
+func redactSecret(v string) string {
+	if v == "" {
+		return ""
+	}
+	return redacted
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EndpointOther is the endpoint label of requests without an endpoint template,
+// e.g. requests sent with DoJSON without WithEndpoint.
+const EndpointOther = "other"
+
+// endpointKey carries the endpoint template of a request in its context.
+type endpointKey struct{}
+
+// WithEndpoint returns a context labeling requests with the endpoint template
+// (e.g. "monitoring/state" or "monitoring/{service}/incidents/{incidentID}")
+// in metrics. Templates must not contain dates, IDs or other unbounded values.
+func WithEndpoint(ctx context.Context, endpoint string) context.Context {
+	return context.WithValue(ctx, endpointKey{}, endpoint)
+}
+
+// EndpointFrom returns the endpoint template set by WithEndpoint, or EndpointOther.
+func EndpointFrom(ctx context.Context) string {
+	if e, ok := ctx.Value(endpointKey{}).(string); ok && e != "" {
+		return e
+	}
+	return EndpointOther
+}
+
+// Metrics collects Prometheus metrics for ICANN API calls. Create it once with
+// NewMetrics and pass it to every client with WithMetrics.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewMetrics creates the request counter, latency histogram and error counter and
+// registers them with reg; a nil reg fails with ErrNilRegisterer rather than falling
+// back to the global registry. Series are labeled by api (mosapi or rri), endpoint
+// template, tld (or registrar IANA ID) and code (the HTTP status, or "error" if no
+// response was received).
+func NewMetrics(reg prometheus.Registerer) (*Metrics, error) {
+	if reg == nil {
+		return nil, ErrNilRegisterer
+	}
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icann",
+			Subsystem: "client",
+			Name:      "requests_total",
+			Help:      "ICANN API calls by API, endpoint template, TLD and status code.",
+		}, []string{"api", "endpoint", "tld", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "icann",
+			Subsystem: "client",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of ICANN API calls, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"api", "endpoint", "tld"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "icann",
+			Subsystem: "client",
+			Name:      "request_errors_total",
+			Help:      "Failed ICANN API calls (transport errors and HTTP 4xx/5xx) by API, endpoint template, TLD and status code.",
+		}, []string{"api", "endpoint", "tld", "code"}),
+	}
+	for _, c := range []prometheus.Collector{m.requests, m.duration, m.errors} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// WithMetrics records every API call in m. Calls are measured outside of
+// authentication, retries and rate limiting, so a retried call counts once
+// with its final status and total latency.
+func WithMetrics(m *Metrics) Option {
+	return func(o *options) { o.metrics = m }
+}
+
+// metricsTransport records the calls sent through it.
+type metricsTransport struct {
+	metrics *Metrics
+	api     string
+	tld     string
+	base    http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := EndpointFrom(req.Context())
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(t.api, endpoint, t.tld).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	t.metrics.requests.WithLabelValues(t.api, endpoint, t.tld, code).Inc()
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		t.metrics.errors.WithLabelValues(t.api, endpoint, t.tld, code).Inc()
+	}
+	return resp, err
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestWithMetrics_LabelsAndRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/flaky":
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer srv.Close()
+
+	reg := prometheus.NewRegistry()
+	m, err := NewMetrics(reg)
+	if err != nil {
+		t.Fatalf("NewMetrics: %v", err)
+	}
+	cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL,
+		Retry: &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}}
+	c, err := NewClient(cfg, WithMetrics(m))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := WithEndpoint(context.Background(), "monitoring/state")
+	for _, path := range []string{"/ok", "/flaky"} {
+		resp, err := c.DoJSON(ctx, http.MethodGet, path, nil, nil)
+		if err != nil {
+			t.Fatalf("DoJSON(%s): %v", path, err)
+		}
+		resp.Body.Close()
+	}
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/missing", nil, nil); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("DoJSON(/missing) = %v, want ErrNotFound", err)
+	}
+
+	// The retried call counts once, with its final status
+	if got := testutil.ToFloat64(m.requests.WithLabelValues(API_MOSAPI, "monitoring/state", "example", "200")); got != 2 {
+		t.Fatalf("requests{monitoring/state,200} = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.errors.WithLabelValues(API_MOSAPI, EndpointOther, "example", "404")); got != 1 {
+		t.Fatalf("errors{other,404} = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.errors); got != 1 {
+		t.Fatalf("error series = %d, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.duration); got != 2 {
+		t.Fatalf("duration series = %d, want 2", got)
+	}
+}
+
+func TestWithMetrics_TransportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Close()
+
+	m, err := NewMetrics(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatalf("NewMetrics: %v", err)
+	}
+	c, err := NewAPIClient(API_RRI, Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", RRIURL: srv.URL}, WithMetrics(m))
+	if err != nil {
+		t.Fatalf("NewAPIClient: %v", err)
+	}
+	if _, err := c.DoJSON(WithEndpoint(context.Background(), "escrow"), http.MethodGet, "/", nil, nil); err == nil {
+		t.Fatal("DoJSON to a closed server succeeded")
+	}
+	if got := testutil.ToFloat64(m.errors.WithLabelValues(API_RRI, "escrow", "example", "error")); got != 1 {
+		t.Fatalf("errors{rri,escrow,error} = %v, want 1", got)
+	}
+}
+
+func TestNewMetrics_DuplicateRegistration(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := NewMetrics(reg); err != nil {
+		t.Fatalf("NewMetrics: %v", err)
+	}
+	var are prometheus.AlreadyRegisteredError
+	if _, err := NewMetrics(reg); !errors.As(err, &are) {
+		t.Fatalf("second NewMetrics = %v, want AlreadyRegisteredError", err)
+	}
+	if _, err := NewMetrics(nil); !errors.Is(err, ErrNilRegisterer) {
+		t.Fatalf("NewMetrics(nil) = %v, want ErrNilRegisterer", err)
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware wraps the client's transport, e.g. to add headers, audit logging
+// or metrics. Middlewares must not modify the request they receive; clone it first.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// Option customizes a Client in NewClient and NewAPIClient.
+type Option func(*options)
+
+// options collects the settings applied by Option values.
+type options struct {
+	middleware []Middleware
+	timeout    time.Duration
+	logger     *slog.Logger
+	metrics    *Metrics
+
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithMiddleware wraps the client's transport, outside of authentication, retries
+// and rate limiting, so middlewares see every request exactly once regardless of
+// the auth type. The first middleware is the outermost.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(o *options) { o.middleware = append(o.middleware, mw...) }
+}
+
+// chain wraps rt with the middlewares, the first one outermost.
+func (o options) chain(rt http.RoundTripper) http.RoundTripper {
+	for i := len(o.middleware) - 1; i >= 0; i-- {
+		rt = o.middleware[i](rt)
+	}
+	return rt
+}
+
+// RoundTripperFunc adapts a function to http.RoundTripper, which is convenient for writing middlewares.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// UserAgent returns a middleware setting the User-Agent header on every request.
+func UserAgent(ua string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			r := req.Clone(req.Context())
+			r.Header.Set("User-Agent", ua)
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+// DefaultRequestIDHeader is the header used by RequestID when none is given.
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// RequestID returns a middleware setting header (DefaultRequestIDHeader if empty)
+// to a value from newID, unless the request already carries one. A nil newID
+// generates random 128-bit hex IDs.
+func RequestID(header string, newID func() string) Middleware {
+	if header == "" {
+		header = DefaultRequestIDHeader
+	}
+	if newID == nil {
+		newID = randomID
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(header) != "" {
+				return next.RoundTrip(req)
+			}
+			r := req.Clone(req.Context())
+			r.Header.Set(header, newID())
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+func randomID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// MaxResponseBytes returns a middleware limiting response bodies to n bytes.
+// Reading past the limit fails with ErrResponseTooLarge.
+func MaxResponseBytes(n int64) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			if resp.ContentLength > n {
+				resp.Body.Close()
+				return nil, ErrResponseTooLarge
+			}
+			resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: n}
+			return resp, nil
+		})
+	}
+}
+
+// limitedBody fails reads once more than the allowed number of bytes was returned.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	// Read one byte past the limit to detect oversized bodies
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrResponseTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithMiddleware_OrderAndAuthModes(t *testing.T) {
+	var serverAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cert, key := newTestCertificate(t, time.Now().Add(24*time.Hour))
+	configs := map[string]Config{
+		"basic": {TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL},
+		"tlsa":  {TLD: "example", AuthType: AUTH_TYPE_TLSA, CertificatePEM: string(certPEM(cert)), KeyPEM: string(keyPEM(t, key)), MOSAPIURL: srv.URL},
+	}
+	for name, cfg := range configs {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			record := func(label string) Middleware {
+				return func(next http.RoundTripper) http.RoundTripper {
+					return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						calls = append(calls, label+":"+req.Header.Get("Authorization"))
+						return next.RoundTrip(req)
+					})
+				}
+			}
+			c, err := NewClient(cfg, WithMiddleware(record("outer"), record("inner")))
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+			resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+			if err != nil {
+				t.Fatalf("DoJSON: %v", err)
+			}
+			resp.Body.Close()
+			// Middlewares run outside authentication, first one outermost
+			if strings.Join(calls, ",") != "outer:,inner:" {
+				t.Fatalf("calls = %v, want [outer: inner:]", calls)
+			}
+			if (cfg.AuthType == AUTH_TYPE_BASIC) != (serverAuth != "") {
+				t.Fatalf("server Authorization = %q for %s", serverAuth, cfg.AuthType)
+			}
+		})
+	}
+}
+
+func TestUserAgentAndRequestID(t *testing.T) {
+	var gotUA, gotID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA, gotID = r.Header.Get("User-Agent"), r.Header.Get("X-Request-ID")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL},
+		WithMiddleware(UserAgent("registry-monitor/1.2"), RequestID("", func() string { return "req-1" })))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if gotUA != "registry-monitor/1.2" || gotID != "req-1" {
+		t.Fatalf("User-Agent = %q, X-Request-ID = %q", gotUA, gotID)
+	}
+
+	// An ID already set by the caller is kept
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "/state", nil)
+	req.Header.Set("X-Request-ID", "caller")
+	resp, err = c.Do(req)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	resp.Body.Close()
+	if gotID != "caller" {
+		t.Fatalf("X-Request-ID = %q, want caller", gotID)
+	}
+}
+
+func TestRequestID_DefaultGenerator(t *testing.T) {
+	var ids []string
+	rt := RequestID("X-Correlation-ID", nil)(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		ids = append(ids, req.Header.Get("X-Correlation-ID"))
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+		rt.RoundTrip(req)
+	}
+	if len(ids) != 2 || len(ids[0]) != 32 || ids[0] == ids[1] {
+		t.Fatalf("ids = %v, want two distinct 32-char hex IDs", ids)
+	}
+}
+
+func TestMaxResponseBytes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/chunked" {
+			// No Content-Length: the limit is enforced while reading
+			w.(http.Flusher).Flush()
+		}
+		io.WriteString(w, `{"status":"`+strings.Repeat("x", 100)+`"}`)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL},
+		WithMiddleware(MaxResponseBytes(64)))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	var out map[string]string
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/sized", nil, &out); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("DoJSON with Content-Length = %v, want ErrResponseTooLarge", err)
+	}
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/chunked", nil, &out); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("DoJSON chunked = %v, want ErrResponseTooLarge", err)
+	}
+
+	c, err = NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL},
+		WithMiddleware(MaxResponseBytes(1024)))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/chunked", nil, &out)
+	if err != nil {
+		t.Fatalf("DoJSON within limit: %v", err)
+	}
+	resp.Body.Close()
+	if len(out["status"]) != 100 {
+		t.Fatalf("decoded status length = %d, want 100", len(out["status"]))
+	}
+}
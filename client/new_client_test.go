@@ -96,9 +96,9 @@ func TestNewClient_TLSACertLoaded(t *testing.T) {
 		t.Fatalf("NewClient error: %v", err)
 	}
 
-	tr, ok := c.HTTPClient.Transport.(*http.Transport)
+	tr, ok := networkTransport(c)
 	if !ok {
-		t.Fatalf("expected *http.Transport, got %T", c.HTTPClient.Transport)
+		t.Fatalf("expected *http.Transport below the timeout, got %T", c.HTTPClient.Transport)
 	}
 	if tr.TLSClientConfig == nil || len(tr.TLSClientConfig.Certificates) == 0 {
 		t.Fatalf("expected TLS client certificate to be configured")
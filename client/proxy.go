@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// validProxySchemes lists the proxy URL schemes supported by ProxyURL
+var validProxySchemes = []string{"http", "https", "socks5", "socks5h"}
+
+// DialContextFunc dials a network connection; see Config.DialContext.
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// validateNetwork checks the proxy and dialer options.
+func (c *Config) validateNetwork() error {
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil || !slices.Contains(validProxySchemes, u.Scheme) || u.Host == "" {
+			return ErrInvalidProxyURL
+		}
+		if c.ProxyPassword != "" && c.ProxyUsername == "" && u.User.Username() == "" {
+			return ErrProxyUsernameRequired
+		}
+	} else if c.ProxyUsername != "" || c.ProxyPassword != "" {
+		return ErrProxyURLRequired
+	}
+	if c.SourceIP != "" {
+		if net.ParseIP(c.SourceIP) == nil {
+			return ErrInvalidSourceIP
+		}
+		if c.DialContext != nil {
+			return ErrConflictingDialer
+		}
+	}
+	return nil
+}
+
+// configureNetwork applies the proxy and dialer options to tr. Without ProxyURL
+// the transport keeps honoring the environment's proxy settings.
+func (c Config) configureNetwork(tr *http.Transport) error {
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return err
+		}
+		// Credentials in the URL are sent as Proxy-Authorization on CONNECT
+		// (HTTP proxies) or in the SOCKS5 username/password negotiation.
+		// ProxyPassword alone completes a username given in the URL.
+		switch {
+		case c.ProxyUsername != "":
+			u.User = url.UserPassword(c.ProxyUsername, c.ProxyPassword)
+		case c.ProxyPassword != "":
+			u.User = url.UserPassword(u.User.Username(), c.ProxyPassword)
+		}
+		tr.Proxy = http.ProxyURL(u)
+	}
+	switch {
+	case c.DialContext != nil:
+		tr.DialContext = c.DialContext
+	case c.SourceIP != "":
+		// Bind outgoing connections (to the proxy or to ICANN) to a fixed egress address
+		d := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			LocalAddr: &net.TCPAddr{IP: net.ParseIP(c.SourceIP)},
+		}
+		tr.DialContext = d.DialContext
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewClient_HTTPProxyWithAuth(t *testing.T) {
+	var gotURL, gotAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL, gotAuth = r.URL.String(), r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		MOSAPIURL: "http://mosapi.invalid", ProxyURL: proxy.URL, ProxyUsername: "egress", ProxyPassword: "s3cret"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+
+	if gotURL != "http://mosapi.invalid/ry/example/v2/monitoring/state" {
+		t.Fatalf("proxied URL = %q", gotURL)
+	}
+	if want := "Basic " + base64.StdEncoding.EncodeToString([]byte("egress:s3cret")); gotAuth != want {
+		t.Fatalf("Proxy-Authorization = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestNewClient_HTTPProxyPasswordWithURLUsername(t *testing.T) {
+	var gotAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL := strings.Replace(proxy.URL, "http://", "http://egress@", 1)
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		MOSAPIURL: "http://mosapi.invalid", ProxyURL: proxyURL, ProxyPassword: "s3cret"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if want := "Basic " + base64.StdEncoding.EncodeToString([]byte("egress:s3cret")); gotAuth != want {
+		t.Fatalf("Proxy-Authorization = %q, want %q", gotAuth, want)
+	}
+}
+
+// serveSOCKS5 accepts a single SOCKS5 connection requiring username/password
+// auth and relays it to the requested address.
+func serveSOCKS5(t *testing.T, ln net.Listener, user, pass string, gotTarget chan<- string) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	buf := make([]byte, 512)
+	// Greeting: VER NMETHODS METHODS...
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return
+	}
+	io.ReadFull(conn, buf[:buf[1]])
+	conn.Write([]byte{5, 2}) // username/password
+	// Auth: VER ULEN UNAME PLEN PASSWD
+	io.ReadFull(conn, buf[:2])
+	u := make([]byte, buf[1])
+	io.ReadFull(conn, u)
+	io.ReadFull(conn, buf[:1])
+	p := make([]byte, buf[0])
+	io.ReadFull(conn, p)
+	if string(u) != user || string(p) != pass {
+		conn.Write([]byte{1, 1})
+		return
+	}
+	conn.Write([]byte{1, 0})
+	// Request: VER CMD RSV ATYP DST.ADDR DST.PORT
+	io.ReadFull(conn, buf[:4])
+	var host string
+	switch buf[3] {
+	case 1:
+		io.ReadFull(conn, buf[:4])
+		host = net.IP(buf[:4]).String()
+	case 3:
+		io.ReadFull(conn, buf[:1])
+		name := make([]byte, buf[0])
+		io.ReadFull(conn, name)
+		host = string(name)
+	default:
+		t.Errorf("unexpected SOCKS5 address type %d", buf[3])
+		return
+	}
+	io.ReadFull(conn, buf[:2])
+	target := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(buf[:2]))))
+	gotTarget <- target
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		conn.Write([]byte{5, 1, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer upstream.Close()
+	conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	go io.Copy(upstream, conn)
+	io.Copy(conn, upstream)
+}
+
+func TestNewClient_SOCKS5Proxy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }))
+	defer srv.Close()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+	gotTarget := make(chan string, 1)
+	go serveSOCKS5(t, ln, "egress", "s3cret", gotTarget)
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		MOSAPIURL: srv.URL, ProxyURL: "socks5://egress:s3cret@" + ln.Addr().String()})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON via SOCKS5: %v", err)
+	}
+	resp.Body.Close()
+	if target := <-gotTarget; target != srv.Listener.Addr().String() {
+		t.Fatalf("SOCKS5 target = %q, want %q", target, srv.Listener.Addr().String())
+	}
+}
+
+func TestNewClient_SourceIPAndDialContext(t *testing.T) {
+	var remoteHost string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remoteHost, _, _ = net.SplitHostPort(r.RemoteAddr)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL, SourceIP: "127.0.0.1"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if remoteHost != "127.0.0.1" {
+		t.Fatalf("remote host = %q, want 127.0.0.1", remoteHost)
+	}
+
+	var dials atomic.Int32
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dials.Add(1)
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+	c, err = NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL, DialContext: dial})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err = c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if dials.Load() != 1 {
+		t.Fatalf("custom dialer calls = %d, want 1", dials.Load())
+	}
+}
+
+func TestConfig_ValidateNetwork(t *testing.T) {
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) { return nil, nil }
+	tests := []struct {
+		name    string
+		mutate  func(c *Config)
+		wantErr error
+	}{
+		{"unsupported proxy scheme", func(c *Config) { c.ProxyURL = "ftp://proxy:21" }, ErrInvalidProxyURL},
+		{"proxy without host", func(c *Config) { c.ProxyURL = "proxy.internal:3128" }, ErrInvalidProxyURL},
+		{"proxy auth without URL", func(c *Config) { c.ProxyUsername = "egress" }, ErrProxyURLRequired},
+		{"proxy password without username", func(c *Config) { c.ProxyURL, c.ProxyPassword = "http://proxy:3128", "s3cret" }, ErrProxyUsernameRequired},
+		{"proxy password with username in URL", func(c *Config) { c.ProxyURL, c.ProxyPassword = "http://egress@proxy:3128", "s3cret" }, nil},
+		{"invalid source IP", func(c *Config) { c.SourceIP = "10.0.0" }, ErrInvalidSourceIP},
+		{"source IP and dialer", func(c *Config) { c.SourceIP, c.DialContext = "10.0.0.1", dial }, ErrConflictingDialer},
+		{"valid", func(c *Config) { c.ProxyURL, c.ProxyUsername, c.SourceIP = "socks5h://proxy:1080", "egress", "::1" }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", Version: V2, Entity: EntityRegistry, Environment: ENV_PROD}
+			tt.mutate(&cfg)
+			if err := cfg.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// RateLimit configures client-side throttling with a token bucket. Clients built
+// from the same credentials (environment, entity, TLD or IANA ID, and auth
+// identity) share a single bucket, so MOSAPI and RRI clients created from one
+// Config draw from the same budget. Clients sharing a bucket must agree on its rate,
+// burst and clock; NewAPIClient returns ErrRateLimitMismatch otherwise.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained request rate. Zero disables rate limiting.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent without waiting. Defaults to 1.
+	Burst int
+	// Clock is used to wait for tokens. Defaults to the system clock.
+	Clock Clock
+}
+
+// validate checks the rate limit for values that cannot be applied.
+func (r *RateLimit) validate() error {
+	if r.RequestsPerSecond < 0 || r.Burst < 0 {
+		return ErrInvalidRateLimit
+	}
+	return nil
+}
+
+// limiters holds the token buckets shared by clients with the same credentials.
+// Buckets are never evicted, so their state outlives the clients using them.
+var limiters sync.Map // map[string]*tokenBucket
+
+// sharedLimiter returns the token bucket for cfg's credentials, creating it from rl if
+// needed. It fails with ErrRateLimitMismatch if the existing bucket has a different
+// rate, burst or clock than rl.
+func sharedLimiter(cfg Config, rl RateLimit) (*tokenBucket, error) {
+	b := newTokenBucket(rl)
+	v, loaded := limiters.LoadOrStore(cfg.credentialKey(), b)
+	existing := v.(*tokenBucket)
+	if loaded && !existing.sameSettings(b) {
+		return nil, ErrRateLimitMismatch
+	}
+	return existing, nil
+}
+
+// credentialKey identifies the credentials and rate-limited scope of a Config
+// without retaining secrets.
+func (c Config) credentialKey() string {
+	h := sha256.New()
+	for _, v := range []string{c.Environment, c.Entity, c.EntityID(), c.AuthType, c.Username, c.CertificatePEM, c.CertificateFile, c.PKCS12File} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tokenBucket is a minimal token bucket limiter. It can additionally be
+// blocked until a point in time when the server asks us to back off.
+type tokenBucket struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	clock        Clock
+}
+
+func newTokenBucket(rl RateLimit) *tokenBucket {
+	burst := float64(rl.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	clock := rl.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &tokenBucket{rate: rl.RequestsPerSecond, burst: burst, tokens: burst, last: clock.Now(), clock: clock}
+}
+
+// sameSettings reports whether o has b's rate, burst and clock. Clocks of types that
+// cannot be compared are never the same.
+func (b *tokenBucket) sameSettings(o *tokenBucket) bool {
+	if b.rate != o.rate || b.burst != o.burst {
+		return false
+	}
+	t := reflect.TypeOf(b.clock)
+	return t == reflect.TypeOf(o.clock) && t.Comparable() && b.clock == o.clock
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := b.clock.Now()
+		if elapsed := now.Sub(b.last); elapsed > 0 {
+			b.tokens = min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+			b.last = now
+		}
+		var wait time.Duration
+		switch {
+		case now.Before(b.blockedUntil):
+			wait = b.blockedUntil.Sub(now)
+		case b.tokens >= 1:
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		default:
+			wait = time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		}
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.clock.After(wait):
+		}
+	}
+}
+
+// blockFor stops handing out tokens for d, e.g. after a 429 with Retry-After.
+func (b *tokenBucket) blockFor(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := b.clock.Now().Add(d); until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// rateLimitTransport waits for a token before every request and pauses the
+// shared bucket when the server responds with 429 and a Retry-After.
+type rateLimitTransport struct {
+	bucket *tokenBucket
+	base   http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.bucket.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After"), t.bucket.clock.Now()); ok {
+			t.bucket.blockFor(ra)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRateLimitTestClient(t *testing.T, cfg Config, url string) *Client {
+	t.Helper()
+	// Buckets are shared process-wide; start every test from a clean registry
+	t.Cleanup(limiters.Clear)
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := c.WithBaseURL(url); err != nil {
+		t.Fatalf("WithBaseURL: %v", err)
+	}
+	return c
+}
+
+func TestRateLimit_SharedAcrossClientsWithSameCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }))
+	defer srv.Close()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	cfg := Config{TLD: "ratelimit-shared", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		RateLimit: &RateLimit{RequestsPerSecond: 2, Burst: 2, Clock: clock}}
+	a := newRateLimitTestClient(t, cfg, srv.URL)
+	b := newRateLimitTestClient(t, cfg, srv.URL)
+
+	for i, c := range []*Client{a, b, a, b} {
+		resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		resp.Body.Close()
+	}
+	// Burst of 2 is shared, then one token every 500ms
+	waits := clock.Waits()
+	if len(waits) != 2 || waits[0] != 500*time.Millisecond || waits[1] != 500*time.Millisecond {
+		t.Fatalf("waits = %v, want [500ms 500ms]", waits)
+	}
+}
+
+func TestRateLimit_SeparateCredentialsHaveSeparateBuckets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }))
+	defer srv.Close()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	rl := &RateLimit{RequestsPerSecond: 1, Clock: clock}
+	a := newRateLimitTestClient(t, Config{TLD: "ratelimit-a", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", RateLimit: rl}, srv.URL)
+	b := newRateLimitTestClient(t, Config{TLD: "ratelimit-b", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", RateLimit: rl}, srv.URL)
+
+	for _, c := range []*Client{a, b} {
+		resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+		if err != nil {
+			t.Fatalf("DoJSON: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if waits := clock.Waits(); len(waits) != 0 {
+		t.Fatalf("waits = %v, want none", waits)
+	}
+}
+
+func TestRateLimit_MismatchedSettingsRejected(t *testing.T) {
+	t.Cleanup(limiters.Clear)
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	cfg := Config{TLD: "ratelimit-mismatch", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		RateLimit: &RateLimit{RequestsPerSecond: 2, Burst: 2, Clock: clock}}
+	if _, err := NewClient(cfg); err != nil {
+		t.Fatalf("first client: %v", err)
+	}
+	// A second client from the same Config shares the bucket
+	if _, err := NewClient(cfg); err != nil {
+		t.Fatalf("same settings: %v", err)
+	}
+
+	for name, rl := range map[string]RateLimit{
+		"rate":  {RequestsPerSecond: 5, Burst: 2, Clock: clock},
+		"burst": {RequestsPerSecond: 2, Burst: 4, Clock: clock},
+		"clock": {RequestsPerSecond: 2, Burst: 2, Clock: &fakeClock{now: time.Unix(1700000000, 0)}},
+	} {
+		other := cfg
+		other.RateLimit = &rl
+		if _, err := NewClient(other); !errors.Is(err, ErrRateLimitMismatch) {
+			t.Errorf("%s: err = %v, want ErrRateLimitMismatch", name, err)
+		}
+	}
+}
+
+func TestRateLimit_TooManyRequestsPausesBucket(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	c := newRateLimitTestClient(t, Config{TLD: "ratelimit-429", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		RateLimit: &RateLimit{RequestsPerSecond: 10, Burst: 5, Clock: clock}}, srv.URL)
+
+	_, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+	var rle *RateLimitError
+	if !errors.As(err, &rle) || rle.RetryAfter != 30*time.Second {
+		t.Fatalf("err = %#v, want RateLimitError with RetryAfter 30s", err)
+	}
+	var he *HTTPError
+	if !errors.As(err, &he) || he.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want to unwrap to HTTPError 429", err)
+	}
+
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if waits := clock.Waits(); len(waits) != 1 || waits[0] != 30*time.Second {
+		t.Fatalf("waits = %v, want [30s]", waits)
+	}
+}
+
+func TestRateLimit_WaitHonorsContext(t *testing.T) {
+	b := newTokenBucket(RateLimit{RequestsPerSecond: 0.001})
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("first Wait: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Wait = %v, want context.Canceled", err)
+	}
+}
+
+func TestConfig_ValidateRateLimit(t *testing.T) {
+	cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", Version: V2, Entity: EntityRegistry, Environment: ENV_PROD,
+		RateLimit: &RateLimit{RequestsPerSecond: -1}}
+	if err := cfg.Validate(); err != ErrInvalidRateLimit {
+		t.Fatalf("Validate() = %v, want ErrInvalidRateLimit", err)
+	}
+	cfg.RateLimit = &RateLimit{RequestsPerSecond: 5, Burst: 10}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+}
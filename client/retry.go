@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Clock abstracts time so that retries and rate limiting can be tested deterministically.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// RetryPolicy configures automatic retries of failed requests. Only idempotent
+// methods (GET, HEAD, OPTIONS, TRACE, PUT, DELETE) are retried, on transport
+// errors and on the configured status codes.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry; it doubles on every further attempt. Defaults to 500ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff delay. A Retry-After longer than MaxBackoff is not waited for:
+	// the response is returned to the caller instead. Defaults to 30s.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0 to 1) of each backoff delay that is randomized. 0 disables jitter.
+	Jitter float64
+	// RetryStatusCodes lists the response status codes that are retried. Defaults to 429, 502, 503 and 504.
+	RetryStatusCodes []int
+	// Clock is used to wait between attempts. Defaults to the system clock.
+	Clock Clock
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with 3 attempts, exponential backoff from 500ms up
+// to 30s with 20% jitter, retrying 429, 502, 503 and 504 responses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.2,
+	}
+}
+
+// validate checks the policy for values that cannot be applied.
+func (p *RetryPolicy) validate() error {
+	if p.MaxAttempts < 0 || p.InitialBackoff < 0 || p.MaxBackoff < 0 || p.Jitter < 0 || p.Jitter > 1 {
+		return ErrInvalidRetryPolicy
+	}
+	return nil
+}
+
+// withDefaults returns a copy of the policy with zero values replaced by defaults.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = 500 * time.Millisecond
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 30 * time.Second
+	}
+	if len(p.RetryStatusCodes) == 0 {
+		p.RetryStatusCodes = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	}
+	if p.Clock == nil {
+		p.Clock = systemClock{}
+	}
+	return p
+}
+
+// backoff returns the delay before retry number n (starting at 1).
+func (p RetryPolicy) backoff(n int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < n && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		// Randomize the last Jitter fraction of the delay: d*(1-Jitter) .. d
+		spread := float64(d) * p.Jitter
+		d = time.Duration(float64(d) - spread*rand.Float64())
+	}
+	return d
+}
+
+// retryTransport retries idempotent requests according to a RetryPolicy.
+type retryTransport struct {
+	policy RetryPolicy
+	base   http.RoundTripper
+}
+
+func newRetryTransport(p RetryPolicy, base http.RoundTripper) *retryTransport {
+	return &retryTransport{policy: p.withDefaults(), base: base}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.policy.MaxAttempts < 2 || !isIdempotent(req.Method) {
+		return t.base.RoundTrip(req)
+	}
+	// A consumed body can only be replayed if it can be recreated.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			r = req.Clone(withAttempt(req.Context(), attempt))
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+		resp, err := t.base.RoundTrip(r)
+		if attempt >= t.policy.MaxAttempts {
+			return resp, err
+		}
+
+		var wait time.Duration
+		switch {
+		case err != nil:
+			// Do not retry when the caller gave up
+			if req.Context().Err() != nil || errors.Is(err, context.Canceled) {
+				return nil, err
+			}
+			wait = t.policy.backoff(attempt)
+		case slices.Contains(t.policy.RetryStatusCodes, resp.StatusCode):
+			wait = t.policy.backoff(attempt)
+			if ra, ok := parseRetryAfter(resp.Header.Get("Retry-After"), t.policy.Clock.Now()); ok {
+				if ra > t.policy.MaxBackoff {
+					// Too long to wait; let the caller decide
+					return resp, nil
+				}
+				wait = max(wait, ra)
+			}
+			// Drain and close body to allow connection reuse
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-t.policy.Clock.After(wait):
+		}
+	}
+}
+
+// isIdempotent reports whether requests with method can safely be retried.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header value given either in seconds or as an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock records requested waits and fires immediately.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.waits = append(f.waits, d)
+	f.now = f.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+	return ch
+}
+
+func (f *fakeClock) Waits() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.waits...)
+}
+
+func newRetryTestClient(t *testing.T, p *RetryPolicy, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", Retry: p})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := c.WithBaseURL(srv.URL); err != nil {
+		t.Fatalf("WithBaseURL: %v", err)
+	}
+	return c
+}
+
+func TestRetry_ExponentialBackoffUntilSuccess(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second, Clock: clock},
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				t.Errorf("attempt %d without Authorization header", calls.Load()+1)
+			}
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 3 {
+		t.Fatalf("calls = %d, want 3", calls.Load())
+	}
+	waits := clock.Waits()
+	if len(waits) != 2 || waits[0] != time.Second || waits[1] != 2*time.Second {
+		t.Fatalf("waits = %v, want [1s 2s]", waits)
+	}
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	clock := &fakeClock{}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 3, Clock: clock}, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	he, ok := err.(*HTTPError)
+	if !ok || he.StatusCode != http.StatusBadGateway {
+		t.Fatalf("err = %v, want HTTPError 502", err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestRetry_HonorsRetryAfter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Minute, Clock: clock},
+		func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "7")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil); err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	if waits := clock.Waits(); len(waits) != 1 || waits[0] != 7*time.Second {
+		t.Fatalf("waits = %v, want [7s]", waits)
+	}
+}
+
+func TestRetry_RetryAfterBeyondMaxBackoffIsReturned(t *testing.T) {
+	clock := &fakeClock{}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 3, MaxBackoff: 5 * time.Second, Clock: clock}, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	var rle *RateLimitError
+	if !errors.As(err, &rle) || rle.StatusCode != http.StatusTooManyRequests || rle.RetryAfter != time.Hour {
+		t.Fatalf("err = %v, want RateLimitError 429 retry after 1h", err)
+	}
+	if calls.Load() != 1 || len(clock.Waits()) != 0 {
+		t.Fatalf("calls = %d waits = %v, want a single attempt", calls.Load(), clock.Waits())
+	}
+}
+
+func TestRetry_NonIdempotentNotRetried(t *testing.T) {
+	clock := &fakeClock{}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 3, Clock: clock}, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _ = c.DoJSON(context.Background(), http.MethodPost, "/maintenance", map[string]string{"a": "b"}, nil)
+	if calls.Load() != 1 {
+		t.Fatalf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestRetry_NonRetryableStatus(t *testing.T) {
+	clock := &fakeClock{}
+	var calls atomic.Int32
+	c := newRetryTestClient(t, &RetryPolicy{MaxAttempts: 3, Clock: clock}, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _ = c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if calls.Load() != 1 {
+		t.Fatalf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in     string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"10", 10 * time.Second, true},
+		{"-1", 0, false},
+		{"Wed, 01 Jan 2025 00:00:30 GMT", 30 * time.Second, true},
+		{"Tue, 31 Dec 2024 23:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.in, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestConfig_ValidateRetryPolicy(t *testing.T) {
+	cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", Version: V2, Entity: EntityRegistry, Environment: ENV_PROD,
+		Retry: &RetryPolicy{MaxAttempts: 3, Jitter: 1.5}}
+	if err := cfg.Validate(); err != ErrInvalidRetryPolicy {
+		t.Fatalf("Validate() = %v, want ErrInvalidRetryPolicy", err)
+	}
+	cfg.Retry = DefaultRetryPolicy()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() with default policy = %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// sessionTransport authenticates once against the MOSAPI login endpoint and
+// reuses the returned session cookie for subsequent requests. When a request
+// is rejected with 401 (e.g. the session expired) it logs in again and replays
+// the request once.
+type sessionTransport struct {
+	username string
+	password string
+	base     http.RoundTripper
+	jar      http.CookieJar
+
+	// loginURL and logoutURL resolve the endpoints against the client's
+	// current base URL so WithBaseURL is honored.
+	loginURL  func() *url.URL
+	logoutURL func() *url.URL
+
+	mu       sync.Mutex
+	loggedIn bool
+	// gen is incremented on every successful login so concurrent requests
+	// failing with 401 trigger a single re-login.
+	gen uint64
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	gen, err := t.login(req.Context(), 0)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.send(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// The body was consumed and cannot be replayed; surface the 401.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	// Drain and close body to allow connection reuse
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if _, err := t.login(req.Context(), gen); err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return t.send(r)
+}
+
+// send issues req with the session cookies currently held in the jar.
+func (t *sessionTransport) send(req *http.Request) (*http.Response, error) {
+	// Clone the request to avoid mutating the caller's request
+	r := req.Clone(req.Context())
+	r.Header.Del("Cookie")
+	for _, c := range t.jar.Cookies(r.URL) {
+		r.AddCookie(c)
+	}
+	return t.base.RoundTrip(r)
+}
+
+// login performs the login request unless a session is already established.
+// A non-zero stale generation forces a new login if no other request has
+// logged in again since that generation. It returns the current generation.
+func (t *sessionTransport) login(ctx context.Context, stale uint64) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.loggedIn && (stale == 0 || stale != t.gen) {
+		return t.gen, nil
+	}
+
+	u := t.loginURL()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.SetBasicAuth(t.username, t.password)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.loggedIn = false
+		return 0, NewHTTPError(req, resp)
+	}
+	io.Copy(io.Discard, resp.Body)
+	t.jar.SetCookies(u, resp.Cookies())
+	t.loggedIn = true
+	t.gen++
+	return t.gen, nil
+}
+
+// logout ends the session if one is established.
+func (t *sessionTransport) logout(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.loggedIn {
+		return nil
+	}
+	t.loggedIn = false
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.logoutURL().String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := t.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return NewHTTPError(req, resp)
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
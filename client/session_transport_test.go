@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeSessionServer emulates the MOSAPI login/logout endpoints. Sessions are
+// identified by the "id" cookie; expire invalidates the current session.
+type fakeSessionServer struct {
+	logins  atomic.Int32
+	logouts atomic.Int32
+	session atomic.Value // string
+}
+
+func (f *fakeSessionServer) expire() { f.session.Store("") }
+
+func (f *fakeSessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/ry/example/v2/login":
+		u, p, ok := r.BasicAuth()
+		if !ok || u != "alice" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n := f.logins.Add(1)
+		id := "sess-" + strconv.Itoa(int(n))
+		f.session.Store(id)
+		http.SetCookie(w, &http.Cookie{Name: "id", Value: id, Path: "/"})
+	case "/ry/example/v2/logout":
+		f.logouts.Add(1)
+		f.expire()
+	default:
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c, err := r.Cookie("id")
+		cur, _ := f.session.Load().(string)
+		if err != nil || cur == "" || c.Value != cur {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}
+}
+
+func newSessionTestClient(t *testing.T, f *fakeSessionServer) *Client {
+	t.Helper()
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_SESSION, Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := c.WithBaseURL(srv.URL); err != nil {
+		t.Fatalf("WithBaseURL: %v", err)
+	}
+	return c
+}
+
+func TestSessionAuth_LoginOnceAndReuseCookie(t *testing.T) {
+	f := &fakeSessionServer{}
+	c := newSessionTestClient(t, f)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.DoJSON(ctx, http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	if got := f.logins.Load(); got != 1 {
+		t.Fatalf("logins = %d, want 1", got)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if got := f.logouts.Load(); got != 1 {
+		t.Fatalf("logouts = %d, want 1", got)
+	}
+	// Closing twice does not log out again
+	if err := c.Close(); err != nil || f.logouts.Load() != 1 {
+		t.Fatalf("second Close: err=%v logouts=%d", err, f.logouts.Load())
+	}
+}
+
+func TestClose_ClosesIdleConnections(t *testing.T) {
+	closed := make(chan struct{}, 1)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateClosed {
+			closed <- struct{}{}
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	_ = c.WithBaseURL(srv.URL)
+
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/x", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("idle connection still open after Close")
+	}
+}
+
+func TestSessionAuth_ReloginOn401(t *testing.T) {
+	f := &fakeSessionServer{}
+	c := newSessionTestClient(t, f)
+	ctx := context.Background()
+
+	if _, err := c.DoJSON(ctx, http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	f.expire()
+	// POST with a body is replayed after the re-login
+	if _, err := c.DoJSON(ctx, http.MethodPost, "/ry/example/v2/maintenance", map[string]string{"a": "b"}, nil); err != nil {
+		t.Fatalf("request after expiry: %v", err)
+	}
+	if got := f.logins.Load(); got != 2 {
+		t.Fatalf("logins = %d, want 2", got)
+	}
+}
+
+func TestSessionAuth_LoginFailure(t *testing.T) {
+	f := &fakeSessionServer{}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_SESSION, Username: "alice", Password: "wrong"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	_ = c.WithBaseURL(srv.URL)
+
+	_, err = c.DoJSON(context.Background(), http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "http error: 401") || !strings.Contains(err.Error(), "/login") {
+		t.Fatalf("err = %v, want login 401", err)
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout bounds each HTTP attempt unless WithTimeout is used.
+const DefaultTimeout = 30 * time.Second
+
+// WithTimeout bounds every HTTP attempt (connecting, sending the request and reading
+// the response body) to d, DefaultTimeout if not positive. The timeout applies per
+// attempt, so retry backoffs and rate limit waits do not count against it; bound a
+// whole call, retries included, with the request context.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) { o.timeout = d }
+}
+
+// timeoutTransport applies a deadline to every round trip, released when the
+// response body is closed.
+type timeoutTransport struct {
+	timeout time.Duration
+	base    http.RoundTripper
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody releases the attempt's deadline when the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// networkTransport returns the *http.Transport of a client built without transport
+// layers other than the per-attempt timeout.
+func networkTransport(c *Client) (*http.Transport, bool) {
+	t, ok := c.HTTPClient.Transport.(*timeoutTransport)
+	if !ok {
+		return nil, false
+	}
+	tr, ok := t.base.(*http.Transport)
+	return tr, ok
+}
+
+// getThrough sends a GET to a test server running handler through rt.
+func getThrough(t *testing.T, rt http.RoundTripper, handler http.HandlerFunc) (*http.Response, error) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return (&http.Client{Transport: rt}).Do(req)
+}
+
+func TestTimeout_RetryAfterLongerThanTimeout(t *testing.T) {
+	// The system clock waits the full Retry-After, well past the per-attempt timeout
+	var calls atomic.Int32
+	rt := newRetryTransport(RetryPolicy{MaxAttempts: 2}, &timeoutTransport{timeout: 300 * time.Millisecond, base: http.DefaultTransport})
+
+	start := time.Now()
+	resp, err := getThrough(t, rt, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	if body, _ := io.ReadAll(resp.Body); string(body) != "ok" || calls.Load() != 2 {
+		t.Fatalf("body = %q after %d calls", body, calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("returned after %v, want the Retry-After wait", elapsed)
+	}
+}
+
+func TestTimeout_RateLimitPauseLongerThanTimeout(t *testing.T) {
+	var calls atomic.Int32
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 100, Burst: 10})
+	rt := newRetryTransport(RetryPolicy{MaxAttempts: 2, RetryStatusCodes: []int{http.StatusTooManyRequests}},
+		&rateLimitTransport{bucket: bucket, base: &timeoutTransport{timeout: 300 * time.Millisecond, base: http.DefaultTransport}})
+
+	resp, err := getThrough(t, rt, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 2 {
+		t.Fatalf("calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestTimeout_SlowAttempt(t *testing.T) {
+	rt := &timeoutTransport{timeout: 100 * time.Millisecond, base: http.DefaultTransport}
+	_, err := getThrough(t, rt, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer srv.Close()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p"}, WithTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := c.WithBaseURL(srv.URL); err != nil {
+		t.Fatalf("WithBaseURL: %v", err)
+	}
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/x", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestTimeout_CoversBodyRead(t *testing.T) {
+	rt := &timeoutTransport{timeout: 100 * time.Millisecond, base: http.DefaultTransport}
+	resp, err := getThrough(t, rt, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	})
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("reading the body: %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/youmark/pkcs8"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// validateTLS checks that the TLS client certificate is configured from exactly
+// one source and that any referenced files are readable.
+func (c *Config) validateTLS() error {
+	if c.AuthType == AUTH_TYPE_TLSA {
+		if c.PKCS12File != "" {
+			if c.CertificatePEM != "" || c.CertificateFile != "" || c.KeyPEM != "" || c.KeyFile != "" {
+				return ErrConflictingCertSources
+			}
+		} else {
+			if c.CertificatePEM != "" && c.CertificateFile != "" {
+				return ErrConflictingCertSources
+			}
+			if c.KeyPEM != "" && c.KeyFile != "" {
+				return ErrConflictingCertSources
+			}
+			if c.CertificatePEM == "" && c.CertificateFile == "" {
+				return ErrCertRequired
+			}
+			if c.KeyPEM == "" && c.KeyFile == "" {
+				return ErrKeyRequired
+			}
+		}
+		for _, f := range []string{c.CertificateFile, c.KeyFile, c.PKCS12File} {
+			if err := checkReadable(f); err != nil {
+				return err
+			}
+		}
+	}
+	if c.CAPEM != "" && c.CAFile != "" {
+		return ErrConflictingCASources
+	}
+	return checkReadable(c.CAFile)
+}
+
+// checkReadable returns an ErrTLSFileUnreadable error if path is set but cannot be opened.
+func checkReadable(path string) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTLSFileUnreadable, err)
+	}
+	return f.Close()
+}
+
+// clientCertificate loads the TLS client certificate from PEM strings, PEM files
+// or a PKCS#12 bundle, decrypting the private key with KeyPassphrase if needed.
+func (c Config) clientCertificate() (tls.Certificate, error) {
+	if c.PKCS12File != "" {
+		return loadPKCS12(c.PKCS12File, c.PKCS12Password)
+	}
+	certPEM, err := pemSource(c.CertificatePEM, c.CertificateFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err := pemSource(c.KeyPEM, c.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err = decryptKeyPEM(keyPEM, c.KeyPassphrase)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// rootCAs returns the custom CA pool from CAPEM or CAFile, or nil to use the system roots.
+func (c Config) rootCAs() (*x509.CertPool, error) {
+	if c.CAPEM == "" && c.CAFile == "" {
+		return nil, nil
+	}
+	caPEM, err := pemSource(c.CAPEM, c.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, ErrInvalidCA
+	}
+	return pool, nil
+}
+
+// pemSource returns the inline PEM if set, otherwise the contents of file.
+func pemSource(inline, file string) ([]byte, error) {
+	if inline != "" {
+		return []byte(inline), nil
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTLSFileUnreadable, err)
+	}
+	return b, nil
+}
+
+// decryptKeyPEM returns keyPEM with an encrypted private key (PKCS#8
+// "ENCRYPTED PRIVATE KEY" or legacy Proc-Type encrypted PEM) replaced by its
+// decrypted form. Unencrypted keys are returned unchanged.
+func decryptKeyPEM(keyPEM []byte, passphrase string) ([]byte, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return keyPEM, nil
+	}
+	switch {
+	case block.Type == "ENCRYPTED PRIVATE KEY":
+		if passphrase == "" {
+			return nil, ErrKeyPassphraseRequired
+		}
+		key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+		if err != nil {
+			return nil, fmt.Errorf("decrypt private key: %w", err)
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+	case x509.IsEncryptedPEMBlock(block):
+		// Legacy PEM encryption is deprecated but still produced by some tooling
+		if passphrase == "" {
+			return nil, ErrKeyPassphraseRequired
+		}
+		der, err := x509.DecryptPEMBlock(block, []byte(passphrase))
+		if err != nil {
+			return nil, fmt.Errorf("decrypt private key: %w", err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der}), nil
+	}
+	return keyPEM, nil
+}
+
+// loadPKCS12 decodes a PKCS#12 bundle holding the client certificate, its
+// private key and optionally intermediate certificates.
+func loadPKCS12(path, password string) (tls.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("%w: %v", ErrTLSFileUnreadable, err)
+	}
+	key, leaf, chain, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("decode PKCS#12 bundle: %w", err)
+	}
+	cert := tls.Certificate{Certificate: [][]byte{leaf.Raw}, PrivateKey: key, Leaf: leaf}
+	for _, c := range chain {
+		cert.Certificate = append(cert.Certificate, c.Raw)
+	}
+	return cert, nil
+}
@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/youmark/pkcs8"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// newTestCertificate returns a self-signed certificate and its ECDSA key.
+func newTestCertificate(t *testing.T, notAfter time.Time) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	tmpl := x509.Certificate{
+		SerialNumber: bigIntOne(),
+		Subject:      pkix.Name{CommonName: "mosapi-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return cert, key
+}
+
+func certPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func keyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func writeTempFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func tlsaConfig() Config {
+	return Config{TLD: "example", AuthType: AUTH_TYPE_TLSA, Version: V2, Entity: EntityRegistry, Environment: ENV_PROD}
+}
+
+// loadedCertificate returns the client certificate configured on c's transport.
+func loadedCertificate(t *testing.T, c *Client) *x509.Certificate {
+	t.Helper()
+	tr, ok := networkTransport(c)
+	if !ok || tr.TLSClientConfig == nil || len(tr.TLSClientConfig.Certificates) == 0 {
+		t.Fatalf("expected TLS client certificate to be configured")
+	}
+	leaf, err := x509.ParseCertificate(tr.TLSClientConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return leaf
+}
+
+func TestNewClient_TLSAFromFiles(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(24*time.Hour))
+	cfg := tlsaConfig()
+	cfg.CertificateFile = writeTempFile(t, "client.crt", certPEM(cert))
+	cfg.KeyFile = writeTempFile(t, "client.key", keyPEM(t, key))
+
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if got := loadedCertificate(t, c); !got.Equal(cert) {
+		t.Fatalf("loaded certificate does not match")
+	}
+}
+
+func TestNewClient_TLSAEncryptedPKCS8Key(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(24*time.Hour))
+	der, err := pkcs8.MarshalPrivateKey(key, []byte("s3cret"), nil)
+	if err != nil {
+		t.Fatalf("MarshalPrivateKey: %v", err)
+	}
+	cfg := tlsaConfig()
+	cfg.CertificatePEM = string(certPEM(cert))
+	cfg.KeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}))
+
+	if _, err := NewClient(cfg); !errors.Is(err, ErrKeyPassphraseRequired) {
+		t.Fatalf("NewClient without passphrase = %v, want ErrKeyPassphraseRequired", err)
+	}
+	cfg.KeyPassphrase = "wrong"
+	if _, err := NewClient(cfg); err == nil {
+		t.Fatalf("NewClient with wrong passphrase succeeded")
+	}
+	cfg.KeyPassphrase = "s3cret"
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	loadedCertificate(t, c)
+}
+
+func TestNewClient_TLSALegacyEncryptedPEMKey(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(24*time.Hour))
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey: %v", err)
+	}
+	block, err := x509.EncryptPEMBlock(rand.Reader, "EC PRIVATE KEY", der, []byte("s3cret"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatalf("EncryptPEMBlock: %v", err)
+	}
+	cfg := tlsaConfig()
+	cfg.CertificatePEM = string(certPEM(cert))
+	cfg.KeyPEM = string(pem.EncodeToMemory(block))
+	cfg.KeyPassphrase = "s3cret"
+
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	loadedCertificate(t, c)
+}
+
+func TestNewClient_TLSAFromPKCS12(t *testing.T) {
+	cert, key := newTestCertificate(t, time.Now().Add(24*time.Hour))
+	p12, err := pkcs12.Modern.Encode(key, cert, nil, "s3cret")
+	if err != nil {
+		t.Fatalf("pkcs12 Encode: %v", err)
+	}
+	cfg := tlsaConfig()
+	cfg.PKCS12File = writeTempFile(t, "client.p12", p12)
+	cfg.PKCS12Password = "s3cret"
+
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if got := loadedCertificate(t, c); !got.Equal(cert) {
+		t.Fatalf("loaded certificate does not match")
+	}
+
+	cfg.PKCS12Password = "wrong"
+	if _, err := NewClient(cfg); err == nil {
+		t.Fatalf("NewClient with wrong PKCS#12 password succeeded")
+	}
+}
+
+func TestNewClient_CustomCAPool(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }))
+	defer srv.Close()
+
+	cfg := Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p",
+		CAFile: writeTempFile(t, "ca.pem", certPEM(srv.Certificate()))}
+	c, err := NewClient(cfg)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := c.WithBaseURL(srv.URL); err != nil {
+		t.Fatalf("WithBaseURL: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON with custom CA: %v", err)
+	}
+	resp.Body.Close()
+
+	cfg.CAFile = ""
+	cfg.CAPEM = "not a certificate"
+	if _, err := NewClient(cfg); !errors.Is(err, ErrInvalidCA) {
+		t.Fatalf("NewClient = %v, want ErrInvalidCA", err)
+	}
+}
+
+func TestConfig_ValidateTLSSources(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.pem")
+	tests := []struct {
+		name    string
+		mutate  func(c *Config)
+		wantErr error
+	}{
+		{"cert PEM and file", func(c *Config) { c.CertificatePEM, c.CertificateFile, c.KeyPEM = "x", "y", "z" }, ErrConflictingCertSources},
+		{"key PEM and file", func(c *Config) { c.CertificatePEM, c.KeyPEM, c.KeyFile = "x", "y", "z" }, ErrConflictingCertSources},
+		{"PKCS12 and key", func(c *Config) { c.PKCS12File, c.KeyPEM = "x.p12", "y" }, ErrConflictingCertSources},
+		{"cert file without key", func(c *Config) { c.CertificateFile = "x" }, ErrKeyRequired},
+		{"missing cert file", func(c *Config) { c.CertificateFile, c.KeyPEM = missing, "y" }, ErrTLSFileUnreadable},
+		{"missing PKCS12 file", func(c *Config) { c.PKCS12File = missing }, ErrTLSFileUnreadable},
+		{"CA PEM and file", func(c *Config) { c.CertificatePEM, c.KeyPEM, c.CAPEM, c.CAFile = "x", "y", "z", "w" }, ErrConflictingCASources},
+		{"missing CA file", func(c *Config) { c.CertificatePEM, c.KeyPEM, c.CAFile = "x", "y", missing }, ErrTLSFileUnreadable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tlsaConfig()
+			tt.mutate(&cfg)
+			if err := cfg.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the client's spans.
+const tracerName = "github.com/onasunnymorning/icann-client/client"
+
+// operationKey carries the logical operation of a request in its context.
+type operationKey struct{}
+
+// WithOperation returns a context naming the spans of its requests after the
+// logical operation, e.g. "mosapi.GetStateResponse".
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFrom returns the operation set by WithOperation, or "".
+func OperationFrom(ctx context.Context) string {
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+// WithTracerProvider creates an OpenTelemetry span per API call using tp, as a
+// child of the span in the request context. Spans are named after the operation
+// (see WithOperation) or "HTTP <method>", and record the API, TLD, environment,
+// endpoint template and status code. They wrap the middlewares and end when the
+// response body is closed, so errors reading the body (such as
+// ErrResponseTooLarge) fail the span. The trace context is propagated to ICANN
+// with the W3C traceparent header unless WithPropagator is given. No global
+// provider or propagator is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) { o.tracerProvider = tp }
+}
+
+// WithPropagator sets the propagator injecting the trace context into requests
+// when tracing is enabled with WithTracerProvider.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(o *options) { o.propagator = p }
+}
+
+// tracingTransport wraps each call in a span.
+type tracingTransport struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	attrs      []attribute.KeyValue
+	base       http.RoundTripper
+}
+
+func newTracingTransport(o options, api string, cfg Config, base http.RoundTripper) *tracingTransport {
+	p := o.propagator
+	if p == nil {
+		p = propagation.TraceContext{}
+	}
+	return &tracingTransport{
+		tracer:     o.tracerProvider.Tracer(tracerName),
+		propagator: p,
+		attrs: []attribute.KeyValue{
+			attribute.String("icann.api", api),
+			attribute.String("icann.tld", cfg.EntityID()),
+			attribute.String("icann.environment", cfg.Environment),
+		},
+		base: base,
+	}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := OperationFrom(req.Context())
+	if name == "" {
+		name = "HTTP " + req.Method
+	}
+	ctx, span := t.tracer.Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(t.attrs...),
+		trace.WithAttributes(
+			attribute.String("icann.endpoint", EndpointFrom(req.Context())),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+		))
+
+	r := req.Clone(ctx)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(r.Header))
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	// Keep the span open while the body is read, like otelhttp
+	resp.Body = &tracedBody{ReadCloser: resp.Body, span: span}
+	return resp, nil
+}
+
+// tracedBody records errors reading a response body in its span and ends the
+// span when the body is closed.
+type tracedBody struct {
+	io.ReadCloser
+	span trace.Span
+	once sync.Once
+}
+
+func (b *tracedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		b.span.RecordError(err)
+		b.span.SetStatus(codes.Error, err.Error())
+	}
+	return n, err
+}
+
+func (b *tracedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.span.End() })
+	return err
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exp := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp)), exp
+}
+
+func spanAttrs(s tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value)
+	for _, kv := range s.Attributes {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestWithTracerProvider_SpanPerCall(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	tp, exp := newTestTracerProvider()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL, Environment: ENV_OTE}, WithTracerProvider(tp))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "poll")
+	ctx = WithEndpoint(WithOperation(ctx, "mosapi.GetStateResponse"), "monitoring/state")
+	resp, err := c.DoJSON(ctx, http.MethodGet, "/ry/example/v2/monitoring/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	parent.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	s := spans[0]
+	if s.Name != "mosapi.GetStateResponse" {
+		t.Fatalf("span name = %q", s.Name)
+	}
+	if s.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Fatal("call span is not a child of the caller's span")
+	}
+	attrs := spanAttrs(s)
+	if attrs["icann.tld"].AsString() != "example" || attrs["icann.environment"].AsString() != ENV_OTE ||
+		attrs["icann.api"].AsString() != API_MOSAPI || attrs["icann.endpoint"].AsString() != "monitoring/state" ||
+		attrs["http.response.status_code"].AsInt64() != 200 {
+		t.Fatalf("attributes = %v", s.Attributes)
+	}
+	want := "00-" + s.SpanContext.TraceID().String() + "-" + s.SpanContext.SpanID().String() + "-01"
+	if traceparent != want {
+		t.Fatalf("traceparent = %q, want %q", traceparent, want)
+	}
+}
+
+func TestWithTracerProvider_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	tp, exp := newTestTracerProvider()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL}, WithTracerProvider(tp))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil); !errors.Is(err, ErrServerError) {
+		t.Fatalf("DoJSON = %v, want ErrServerError", err)
+	}
+	spans := exp.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "HTTP GET" || spans[0].Status.Code != codes.Error {
+		t.Fatalf("spans = %+v, want one failed HTTP GET span", spans)
+	}
+}
+
+func TestWithTracerProvider_SpanCoversBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("0123456789"))
+		w.(http.Flusher).Flush() // no Content-Length, so the limit is hit while reading
+		w.Write([]byte("0123456789"))
+	}))
+	defer srv.Close()
+
+	tp, exp := newTestTracerProvider()
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL},
+		WithTracerProvider(tp), WithMiddleware(MaxResponseBytes(15)))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	if n := len(exp.GetSpans()); n != 0 {
+		t.Fatalf("%d spans ended before the body was read", n)
+	}
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("reading the body: %v, want ErrResponseTooLarge", err)
+	}
+	resp.Body.Close()
+	resp.Body.Close()
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 || spans[0].Status.Code != codes.Error || len(spans[0].Events) != 1 {
+		t.Fatalf("spans = %+v, want one failed span with the read error", spans)
+	}
+}
+
+func TestNewClient_NoTracingByDefault(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Config{TLD: "example", AuthType: AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	resp, err := c.DoJSON(context.Background(), http.MethodGet, "/state", nil, nil)
+	if err != nil {
+		t.Fatalf("DoJSON: %v", err)
+	}
+	resp.Body.Close()
+	if traceparent != "" {
+		t.Fatalf("traceparent = %q without tracing", traceparent)
+	}
+}
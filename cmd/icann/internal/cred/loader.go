@@ -64,6 +64,11 @@ func Load(profile, file string) (Record, error) {
 
 // preprocessPEM collapses multi-line PEM values for keys certificate_pem and key_pem
 // into single-line values with explicit \n separators so the INI parser can handle them.
+//
+// Deprecated: inline PEM is kept only so existing profiles keep loading. The collapsing
+// is line based and knows nothing of INI syntax: any line starting with certificate_pem
+// or key_pem opens a block, and a block without an END line swallows the keys after it.
+// New profiles should point certificate_file/key_file or pkcs12_file at the certificate.
 func preprocessPEM(s string) string {
 	scanner := bufio.NewScanner(strings.NewReader(s))
 	var out bytes.Buffer
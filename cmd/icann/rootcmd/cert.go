@@ -0,0 +1,72 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of `icann cert check`, following the Nagios plugin convention.
+const (
+	certExitWarning  = 1
+	certExitCritical = 2
+	certExitUnknown  = 3
+)
+
+var flagCertWarnDays int
+
+// certCmd groups TLS client certificate operations
+var certCmd = &cobra.Command{
+	Use:   "cert",
+	Short: "TLSA client certificate operations",
+}
+
+var certCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check the TLSA client certificate's validity and expiry",
+	Long: `Check the TLSA client certificate's validity and expiry and print a JSON report.
+
+Exit codes: 0 valid, 1 expiring within --warn-days, 2 expired or not yet valid,
+3 the certificate could not be loaded. --warn-days 0 only fails expired or not
+yet valid certificates.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return &exitError{code: certExitUnknown, err: err}
+		}
+		if flagCertWarnDays < 0 {
+			return &exitError{code: certExitUnknown, err: fmt.Errorf("--warn-days must not be negative")}
+		}
+		// CheckCertificate treats a zero window as the default; 0 days means never warn
+		warnWithin := time.Duration(flagCertWarnDays) * 24 * time.Hour
+		if flagCertWarnDays == 0 {
+			warnWithin = -1
+		}
+		report, err := base.CheckCertificate(cfg, time.Now(), warnWithin)
+		if err != nil {
+			return &exitError{code: certExitUnknown, err: err}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+		switch report.Status {
+		case base.CertificateExpiringSoon:
+			return &exitError{code: certExitWarning, err: report.Err()}
+		case base.CertificateExpired, base.CertificateNotYetValid:
+			return &exitError{code: certExitCritical, err: report.Err()}
+		}
+		return nil
+	},
+}
+
+func init() {
+	certCheckCmd.Flags().IntVar(&flagCertWarnDays, "warn-days", 30, "Warn when the certificate expires within this many days (0 never warns)")
+	certCmd.AddCommand(certCheckCmd)
+	RootCmd.AddCommand(certCmd)
+}
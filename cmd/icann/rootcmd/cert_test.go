@@ -0,0 +1,59 @@
+package rootcmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+// runCertCheck runs `icann cert check` with only --cert-file and --key-file and returns
+// its exit code.
+func runCertCheck(t *testing.T, certFile, keyFile string) int {
+	t.Helper()
+	// Restore the flag variables Execute sets
+	setFlags(t, map[*string]string{&flagTLD: "", &flagCertFile: "", &flagKeyFile: ""})
+	oldWarn, oldStdout := flagCertWarnDays, os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = devNull
+	t.Cleanup(func() { flagCertWarnDays, os.Stdout = oldWarn, oldStdout; devNull.Close() })
+
+	RootCmd.SetErr(io.Discard)
+	t.Cleanup(func() { RootCmd.SetErr(nil); RootCmd.SetArgs(nil) })
+	RootCmd.SetArgs([]string{"cert", "check", "--credentials-file", credentialsFileFlag,
+		"--tld", "example", "--cert-file", certFile, "--key-file", keyFile})
+	err = RootCmd.Execute()
+	if err == nil {
+		return 0
+	}
+	var ee *exitError
+	if !errors.As(err, &ee) {
+		t.Fatalf("cert check: %v", err)
+	}
+	return ee.code
+}
+
+func TestCertCheck_FilesOnly(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		want     int
+	}{
+		{"valid", now.Add(90 * 24 * time.Hour), 0},
+		{"expiring", now.Add(10 * 24 * time.Hour), certExitWarning},
+		{"expired", now.Add(-24 * time.Hour), certExitCritical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certFile, keyFile := writeTestCertificate(t, tt.notAfter)
+			if got := runCertCheck(t, certFile, keyFile); got != tt.want {
+				t.Fatalf("exit code = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onasunnymorning/icann-client/rri"
+	"github.com/onasunnymorning/icann-client/rri/rdereport"
+	"github.com/spf13/cobra"
+)
+
+var flagFile string
+
+// escrowCmd groups registry escrow write operations
+var escrowCmd = &cobra.Command{
+	Use:   "escrow",
+	Short: "Registry escrow notifications",
+}
+
+var escrowNotifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Send the escrow deposit notification (RDE report XML) to ICANN",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagFile == "" {
+			return fmt.Errorf("--file is required (RDE report XML)")
+		}
+		report, err := os.ReadFile(flagFile)
+		if err != nil {
+			return err
+		}
+		var dt time.Time
+		if flagDate != "" {
+			if dt, err = time.Parse("2006-01-02", flagDate); err != nil {
+				return fmt.Errorf("invalid --date: %w", err)
+			}
+		} else {
+			r, err := rdereport.Parse(report)
+			if err != nil {
+				return fmt.Errorf("--date not given and the report cannot be read: %w", err)
+			}
+			if r.Watermark.IsZero() {
+				return fmt.Errorf("--date not given and the report has no watermark")
+			}
+			dt = r.Date()
+		}
+
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := rri.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+
+		out, err := cli.NotifyRyEscrowDeposit(cmd.Context(), dt, report)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+		return out.Err()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(escrowCmd)
+	escrowCmd.AddCommand(escrowNotifyCmd)
+
+	escrowNotifyCmd.Flags().StringVar(&flagFile, "file", "", "Path to the RDE report XML document")
+	escrowNotifyCmd.Flags().StringVar(&flagDate, "date", "", "Deposit date (YYYY-MM-DD) (default: date of the report's watermark)")
+}
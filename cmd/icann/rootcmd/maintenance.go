@@ -0,0 +1,127 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/onasunnymorning/icann-client/mosapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagMaintStart       string
+	flagMaintEnd         string
+	flagMaintDescription string
+)
+
+// maintenanceCmd groups MOSAPI maintenance window operations
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Maintenance window notifications",
+}
+
+var maintenanceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Notify ICANN of a maintenance window",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagMaintStart == "" || flagMaintEnd == "" {
+			return fmt.Errorf("--start and --end are required (RFC 3339, e.g. 2026-11-01T02:00:00Z)")
+		}
+		start, err := time.Parse(time.RFC3339, flagMaintStart)
+		if err != nil {
+			return fmt.Errorf("invalid --start: %w", err)
+		}
+		end, err := time.Parse(time.RFC3339, flagMaintEnd)
+		if err != nil {
+			return fmt.Errorf("invalid --end: %w", err)
+		}
+		w := mosapi.NewMaintenanceWindow(strings.ToUpper(flagService), start, end)
+		w.Description = flagMaintDescription
+		// Validate before resolving credentials so bad input fails fast
+		if err := w.Validate(time.Now()); err != nil {
+			return err
+		}
+
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		// Registrars may only notify maintenance of the services monitored for them
+		if err := base.ValidateEntityService(cfg.Entity, w.Service); err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		out, err := cli.CreateMaintenanceWindow(cmd.Context(), w)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	},
+}
+
+var maintenanceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled maintenance windows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		out, err := cli.ListMaintenanceWindows(cmd.Context())
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	},
+}
+
+var maintenanceDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Cancel a maintenance window",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		if err := cli.DeleteMaintenanceWindow(cmd.Context(), args[0]); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "maintenance window %s deleted\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(maintenanceCmd)
+	maintenanceCmd.AddCommand(maintenanceCreateCmd)
+	maintenanceCmd.AddCommand(maintenanceListCmd)
+	maintenanceCmd.AddCommand(maintenanceDeleteCmd)
+
+	maintenanceCreateCmd.Flags().StringVar(&flagService, "service", "", "Service: DNS, DNSSEC, EPP, RDDS or RDAP")
+	maintenanceCreateCmd.Flags().StringVar(&flagMaintStart, "start", "", "Window start (RFC 3339)")
+	maintenanceCreateCmd.Flags().StringVar(&flagMaintEnd, "end", "", "Window end (RFC 3339)")
+	maintenanceCreateCmd.Flags().StringVar(&flagMaintDescription, "description", "", "Optional description")
+}
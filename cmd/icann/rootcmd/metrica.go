@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	base "github.com/onasunnymorning/icann-client/client"
 	"github.com/onasunnymorning/icann-client/cmd/icann/internal/cred"
@@ -29,10 +30,11 @@ var metricaLatestCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		cli, err := mosapi.New(cfg)
+		cli, err := mosapi.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 		out, err := cli.GetMetricaLatest(cmd.Context())
 		if err != nil {
 			return err
@@ -53,10 +55,11 @@ var metricaDateCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		cli, err := mosapi.New(cfg)
+		cli, err := mosapi.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 		out, err := cli.GetMetricaByDate(cmd.Context(), date)
 		if err != nil {
 			return err
@@ -75,10 +78,11 @@ var metricaListsCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		cli, err := mosapi.New(cfg)
+		cli, err := mosapi.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 		out, err := cli.ListMetricaReports(cmd.Context(), flagStartDate, flagEndDate)
 		if err != nil {
 			return err
@@ -91,13 +95,10 @@ var metricaListsCmd = &cobra.Command{
 
 // buildConfigFromInputs consolidates flags and credentials resolution (shared with state command pattern)
 func buildConfigFromInputs() (base.Config, error) {
-	// Choose profile: explicit --profile, otherwise default to --tld if provided.
-	chosenProfile := profileFlag
-	if chosenProfile == "" && flagTLD != "" {
-		chosenProfile = flagTLD
-	}
+	// Choose profile: explicit --profile, otherwise default to --tld (or --iana-id for registrars) if provided.
+	chosenProfile := firstNonEmpty(profileFlag, flagTLD, flagIANAID)
 	rec, loadErr := cred.Load(chosenProfile, credentialsFileFlag)
-	if loadErr != nil && flagAuth == "" && flagUser == "" && flagPass == "" && flagCertPEM == "" && flagKeyPEM == "" {
+	if loadErr != nil && flagAuth == "" && flagUser == "" && flagPass == "" && !tlsFlagsSet() {
 		return base.Config{}, loadErr
 	}
 	if rec == nil {
@@ -105,22 +106,51 @@ func buildConfigFromInputs() (base.Config, error) {
 	}
 
 	cfg := base.Config{}
-	cfg.TLD = firstNonEmpty(flagTLD, rec["tld"], chosenProfile)
 	cfg.Environment = firstNonEmpty(flagEnv, rec["environment"], base.ENV_PROD)
 	cfg.Version = firstNonEmpty(flagVersion, rec["version"], base.V2)
 	cfg.Entity = firstNonEmpty(flagEntity, rec["entity"], base.EntityRegistry)
-	cfg.AuthType = deriveAuthType(flagAuth, rec)
+	cfg.AuthType = deriveAuthType(flagAuth, tlsFlagsSet(), rec)
 	switch cfg.AuthType {
-	case base.AUTH_TYPE_BASIC:
+	case base.AUTH_TYPE_BASIC, base.AUTH_TYPE_SESSION:
 		cfg.Username = firstNonEmpty(flagUser, rec["username"])
 		cfg.Password = firstNonEmpty(flagPass, rec["password"])
 	case base.AUTH_TYPE_TLSA:
-		cfg.CertificatePEM = expandEscapes(firstNonEmpty(flagCertPEM, rec["certificate_pem"], rec["certificate"]))
-		cfg.KeyPEM = expandEscapes(firstNonEmpty(flagKeyPEM, rec["key_pem"], rec["key"]))
+		if tlsFlagsSet() {
+			// A certificate or key on the command line replaces every TLS source of the profile
+			cfg.CertificatePEM = expandEscapes(flagCertPEM)
+			cfg.KeyPEM = expandEscapes(flagKeyPEM)
+			cfg.CertificateFile, cfg.KeyFile, cfg.PKCS12File = flagCertFile, flagKeyFile, flagP12File
+		} else {
+			// Prefer PEM values; support keys certificate_pem/key_pem; allow fallback to certificate/key if a caller still supplies them
+			cfg.CertificatePEM = expandEscapes(firstNonEmpty(rec["certificate_pem"], rec["certificate"]))
+			cfg.KeyPEM = expandEscapes(firstNonEmpty(rec["key_pem"], rec["key"]))
+			cfg.CertificateFile, cfg.KeyFile, cfg.PKCS12File = rec["certificate_file"], rec["key_file"], rec["pkcs12_file"]
+		}
+		cfg.KeyPassphrase = firstNonEmpty(flagKeyPassphrase, rec["key_passphrase"])
+		cfg.PKCS12Password = firstNonEmpty(flagP12Password, rec["pkcs12_password"])
 	}
+	cfg.CAFile = firstNonEmpty(flagCAFile, rec["ca_file"])
+	cfg.MOSAPIURL = firstNonEmpty(flagMOSAPIURL, rec["mosapi_url"])
+	cfg.RRIURL = firstNonEmpty(flagRRIURL, rec["rri_url"])
+	cfg.ProxyURL = firstNonEmpty(flagProxyURL, rec["proxy_url"])
+	cfg.ProxyUsername = firstNonEmpty(flagProxyUsername, rec["proxy_username"])
+	cfg.ProxyPassword = firstNonEmpty(flagProxyPassword, rec["proxy_password"])
+	cfg.SourceIP = firstNonEmpty(flagSourceIP, rec["source_ip"])
 
-	if cfg.TLD == "" {
-		return base.Config{}, fmt.Errorf("tld is required (provide --tld, credentials tld, or use a profile named after the TLD)")
+	switch cfg.Entity {
+	case base.EntityRegistrar:
+		// Registrars are identified by IANA ID; the profile name may be the IANA ID.
+		raw := firstNonEmpty(flagIANAID, rec["iana_id"], chosenProfile)
+		id, err := strconv.Atoi(raw)
+		if err != nil || id <= 0 {
+			return base.Config{}, fmt.Errorf("iana id is required for entity rr (provide --iana-id, credentials iana_id, or use a profile named after the IANA ID)")
+		}
+		cfg.IANAID = id
+	default:
+		cfg.TLD = firstNonEmpty(flagTLD, rec["tld"], chosenProfile)
+		if cfg.TLD == "" {
+			return base.Config{}, fmt.Errorf("tld is required (provide --tld, credentials tld, or use a profile named after the TLD)")
+		}
 	}
 	if err := cfg.Validate(); err != nil {
 		return base.Config{}, err
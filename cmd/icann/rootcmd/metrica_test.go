@@ -0,0 +1,101 @@
+package rootcmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// writeTestCertificate writes a self-signed certificate valid until notAfter and its key
+// as PEM files and returns their paths.
+func writeTestCertificate(t *testing.T, notAfter time.Time) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+// setFlags assigns flag variables for the duration of the test. Unless given, the
+// credentials file points at a file that does not exist.
+func setFlags(t *testing.T, vals map[*string]string) {
+	t.Helper()
+	if _, ok := vals[&credentialsFileFlag]; !ok {
+		vals[&credentialsFileFlag] = filepath.Join(t.TempDir(), "missing")
+	}
+	for p, v := range vals {
+		old := *p
+		*p = v
+		t.Cleanup(func() { *p = old })
+	}
+}
+
+func TestBuildConfigFromInputs_TLSFlagsWithoutProfile(t *testing.T) {
+	certFile, keyFile := writeTestCertificate(t, time.Now().Add(90*24*time.Hour))
+	setFlags(t, map[*string]string{&flagTLD: "example", &flagCertFile: certFile, &flagKeyFile: keyFile})
+
+	cfg, err := buildConfigFromInputs()
+	if err != nil {
+		t.Fatalf("buildConfigFromInputs: %v", err)
+	}
+	if cfg.AuthType != base.AUTH_TYPE_TLSA || cfg.CertificateFile != certFile || cfg.KeyFile != keyFile {
+		t.Fatalf("cfg = %s %q %q, want tlsa with the flag files", cfg.AuthType, cfg.CertificateFile, cfg.KeyFile)
+	}
+}
+
+func TestBuildConfigFromInputs_TLSFlagsReplaceProfile(t *testing.T) {
+	certFile, keyFile := writeTestCertificate(t, time.Now().Add(90*24*time.Hour))
+	certPEM, _ := os.ReadFile(certFile)
+	keyPEM, _ := os.ReadFile(keyFile)
+	creds := filepath.Join(t.TempDir(), "credentials")
+	profile := "[example]\nauth_type = tlsa\ncertificate_file = /nonexistent/client.crt\nkey_file = /nonexistent/client.key\npkcs12_file = /nonexistent/client.p12\nkey_passphrase = secret\n"
+	if err := os.WriteFile(creds, []byte(profile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	setFlags(t, map[*string]string{&credentialsFileFlag: creds, &flagTLD: "example", &flagCertPEM: string(certPEM), &flagKeyPEM: string(keyPEM)})
+
+	cfg, err := buildConfigFromInputs()
+	if err != nil {
+		t.Fatalf("buildConfigFromInputs: %v", err)
+	}
+	if cfg.CertificateFile != "" || cfg.KeyFile != "" || cfg.PKCS12File != "" {
+		t.Fatalf("profile files kept: %q %q %q", cfg.CertificateFile, cfg.KeyFile, cfg.PKCS12File)
+	}
+	if cfg.CertificatePEM != string(certPEM) || cfg.KeyPassphrase != "secret" {
+		t.Fatalf("cfg does not use the flag PEM and the profile passphrase")
+	}
+}
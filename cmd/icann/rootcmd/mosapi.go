@@ -15,12 +15,25 @@ func init() { RootCmd.AddCommand(mosapiCmd) }
 func init() {
 	// Make common MOSAPI flags persistent so they apply to all subcommands under `mosapi`.
 	mosapiCmd.PersistentFlags().StringVar(&flagTLD, "tld", "", "TLD (e.g., example) [required unless in credentials]")
+	mosapiCmd.PersistentFlags().StringVar(&flagIANAID, "iana-id", "", "Registrar IANA ID (entity rr) [required unless in credentials]")
 	mosapiCmd.PersistentFlags().StringVar(&flagEnv, "env", "", "Environment: prod or ote")
-	mosapiCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic or tlsa")
-	mosapiCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic auth")
-	mosapiCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic auth")
+	mosapiCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic, tlsa or session")
+	mosapiCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic/session auth")
+	mosapiCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic/session auth")
 	mosapiCmd.PersistentFlags().StringVar(&flagCertPEM, "cert-pem", "", "PEM-encoded client certificate for TLSA (string)")
 	mosapiCmd.PersistentFlags().StringVar(&flagKeyPEM, "key-pem", "", "PEM-encoded client key for TLSA (string)")
+	mosapiCmd.PersistentFlags().StringVar(&flagCertFile, "cert-file", "", "Path to PEM client certificate for TLSA")
+	mosapiCmd.PersistentFlags().StringVar(&flagKeyFile, "key-file", "", "Path to PEM client key for TLSA")
+	mosapiCmd.PersistentFlags().StringVar(&flagKeyPassphrase, "key-passphrase", "", "Passphrase for an encrypted TLSA client key")
+	mosapiCmd.PersistentFlags().StringVar(&flagP12File, "p12-file", "", "Path to PKCS#12 (.p12) bundle with client certificate and key for TLSA")
+	mosapiCmd.PersistentFlags().StringVar(&flagP12Password, "p12-password", "", "Password for the PKCS#12 bundle")
+	mosapiCmd.PersistentFlags().StringVar(&flagCAFile, "ca-file", "", "Path to PEM CA bundle used to verify the server")
 	mosapiCmd.PersistentFlags().StringVar(&flagVersion, "version", "", "API version (default v2)")
-	mosapiCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity (default ry)")
+	mosapiCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity: ry (registry) or rr (registrar) (default ry)")
+	mosapiCmd.PersistentFlags().StringVar(&flagMOSAPIURL, "mosapi-url", "", "Override the MOSAPI base URL (e.g., a proxy or local mock)")
+	mosapiCmd.PersistentFlags().StringVar(&flagRRIURL, "rri-url", "", "Override the RRI base URL (e.g., a proxy or local mock)")
+	mosapiCmd.PersistentFlags().StringVar(&flagProxyURL, "proxy-url", "", "Proxy URL: http(s):// for HTTP CONNECT or socks5:// (default: environment proxy settings)")
+	mosapiCmd.PersistentFlags().StringVar(&flagProxyUsername, "proxy-username", "", "Proxy username")
+	mosapiCmd.PersistentFlags().StringVar(&flagProxyPassword, "proxy-password", "", "Proxy password")
+	mosapiCmd.PersistentFlags().StringVar(&flagSourceIP, "source-ip", "", "Local IP address to bind outgoing connections to")
 }
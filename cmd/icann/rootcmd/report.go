@@ -0,0 +1,137 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onasunnymorning/icann-client/rri"
+	"github.com/onasunnymorning/icann-client/rri/reportcsv"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagReportType string
+	flagMonth      string
+)
+
+// reportCmd groups monthly registry report operations
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Monthly registry reports (transactions, activity)",
+}
+
+// reportInputs parses --type and --month.
+func reportInputs() (rri.MonthlyReportType, time.Time, error) {
+	t := rri.MonthlyReportType(flagReportType)
+	if err := t.Validate(); err != nil {
+		return "", time.Time{}, fmt.Errorf("--type: %w", err)
+	}
+	if flagMonth == "" {
+		return "", time.Time{}, fmt.Errorf("--month is required (YYYY-MM)")
+	}
+	month, err := time.Parse("2006-01", flagMonth)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid --month: %w", err)
+	}
+	return t, month, nil
+}
+
+// newRRIClient builds an RRI client from the flags and credentials.
+func newRRIClient() (*rri.Client, error) {
+	cfg, err := buildConfigFromInputs()
+	if err != nil {
+		return nil, err
+	}
+	return rri.New(cfg, clientOptions()...)
+}
+
+var reportUploadCmd = &cobra.Command{
+	Use:   "upload",
+	Short: "Upload a monthly report CSV",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		t, month, err := reportInputs()
+		if err != nil {
+			return err
+		}
+		if flagFile == "" {
+			return fmt.Errorf("--file is required (report CSV)")
+		}
+		csv, err := os.ReadFile(flagFile)
+		if err != nil {
+			return err
+		}
+		cli, err := newRRIClient()
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		out, err := cli.UploadMonthlyReport(cmd.Context(), t, month, csv)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+		return out.Err()
+	},
+}
+
+// validationResult is the output of report validate.
+type validationResult struct {
+	File   string           `json:"file"`
+	Type   string           `json:"type"`
+	Valid  bool             `json:"valid"`
+	Errors reportcsv.Errors `json:"errors,omitempty"`
+}
+
+var reportValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a monthly report CSV offline (header, numeric fields, IANA IDs, totals)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		schema, err := reportcsv.SchemaFor(flagReportType)
+		if err != nil {
+			return fmt.Errorf("--type: %w", err)
+		}
+		if flagFile == "" {
+			return fmt.Errorf("--file is required (report CSV)")
+		}
+		f, err := os.Open(flagFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		out := validationResult{File: flagFile, Type: schema.Name}
+		err = reportcsv.Validate(schema, f)
+		if !errors.As(err, &out.Errors) && err != nil {
+			return err
+		}
+		out.Valid = err == nil
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+		if !out.Valid {
+			return fmt.Errorf("%s: %d validation error(s)", flagFile, len(out.Errors))
+		}
+		return nil
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportUploadCmd)
+	reportUploadCmd.Flags().StringVar(&flagReportType, "type", "", "Report type: transactions or activity")
+	reportUploadCmd.Flags().StringVar(&flagMonth, "month", "", "Report month (YYYY-MM)")
+	reportUploadCmd.Flags().StringVar(&flagFile, "file", "", "Path to the report CSV")
+
+	reportCmd.AddCommand(reportValidateCmd)
+	reportValidateCmd.Flags().StringVar(&flagReportType, "type", "", "Report type: transactions or activity")
+	reportValidateCmd.Flags().StringVar(&flagFile, "file", "", "Path to the report CSV")
+}
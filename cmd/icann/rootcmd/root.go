@@ -1,15 +1,20 @@
 package rootcmd
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 
+	base "github.com/onasunnymorning/icann-client/client"
 	"github.com/spf13/cobra"
 )
 
 var (
 	profileFlag         string
 	credentialsFileFlag string
+	flagVerbose         bool
+	flagDebug           bool
 )
 
 // RootCmd is the base command.
@@ -20,10 +25,24 @@ var RootCmd = &cobra.Command{
 	// We keep default error printing and also print in Execute; alternatively set SilenceErrors: true
 }
 
+// exitError makes Execute exit with a specific code, e.g. for monitoring checks.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
 // Execute runs the root command.
 func Execute() {
 	if err := RootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
+		var ee *exitError
+		if errors.As(err, &ee) {
+			os.Exit(ee.code)
+		}
 		os.Exit(1)
 	}
 }
@@ -31,15 +50,52 @@ func Execute() {
 func init() {
 	RootCmd.PersistentFlags().StringVarP(&profileFlag, "profile", "p", "", "credentials profile (default: env ICANN_PROFILE or 'default')")
 	RootCmd.PersistentFlags().StringVarP(&credentialsFileFlag, "credentials-file", "c", "", "path to credentials file (default: env ICANN_SHARED_CREDENTIALS_FILE or ~/.icann/credentials)")
+	RootCmd.PersistentFlags().BoolVar(&flagVerbose, "verbose", false, "log each HTTP request (method, path, status, latency) to stderr")
+	RootCmd.PersistentFlags().BoolVar(&flagDebug, "debug", false, "like --verbose, and also log request/response headers (secrets redacted)")
 
 	// Global flags for target, auth, and API routing
 	RootCmd.PersistentFlags().StringVar(&flagTLD, "tld", "", "TLD (e.g., example) [required unless in credentials]")
+	RootCmd.PersistentFlags().StringVar(&flagIANAID, "iana-id", "", "Registrar IANA ID (entity rr) [required unless in credentials]")
 	RootCmd.PersistentFlags().StringVar(&flagEnv, "env", "", "Environment: prod or ote")
-	RootCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic or tlsa")
-	RootCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic auth")
-	RootCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic auth")
+	RootCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic, tlsa or session")
+	RootCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic/session auth")
+	RootCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic/session auth")
 	RootCmd.PersistentFlags().StringVar(&flagCertPEM, "cert-pem", "", "PEM-encoded client certificate for TLSA (string)")
 	RootCmd.PersistentFlags().StringVar(&flagKeyPEM, "key-pem", "", "PEM-encoded client key for TLSA (string)")
+	RootCmd.PersistentFlags().StringVar(&flagCertFile, "cert-file", "", "Path to PEM client certificate for TLSA")
+	RootCmd.PersistentFlags().StringVar(&flagKeyFile, "key-file", "", "Path to PEM client key for TLSA")
+	RootCmd.PersistentFlags().StringVar(&flagKeyPassphrase, "key-passphrase", "", "Passphrase for an encrypted TLSA client key")
+	RootCmd.PersistentFlags().StringVar(&flagP12File, "p12-file", "", "Path to PKCS#12 (.p12) bundle with client certificate and key for TLSA")
+	RootCmd.PersistentFlags().StringVar(&flagP12Password, "p12-password", "", "Password for the PKCS#12 bundle")
+	RootCmd.PersistentFlags().StringVar(&flagCAFile, "ca-file", "", "Path to PEM CA bundle used to verify the server")
 	RootCmd.PersistentFlags().StringVar(&flagVersion, "version", "", "API version (default v2)")
-	RootCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity (default ry)")
+	RootCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity: ry (registry) or rr (registrar) (default ry)")
+	RootCmd.PersistentFlags().StringVar(&flagMOSAPIURL, "mosapi-url", "", "Override the MOSAPI base URL (e.g., a proxy or local mock)")
+	RootCmd.PersistentFlags().StringVar(&flagRRIURL, "rri-url", "", "Override the RRI base URL (e.g., a proxy or local mock)")
+	RootCmd.PersistentFlags().StringVar(&flagProxyURL, "proxy-url", "", "Proxy URL: http(s):// for HTTP CONNECT or socks5:// (default: environment proxy settings)")
+	RootCmd.PersistentFlags().StringVar(&flagProxyUsername, "proxy-username", "", "Proxy username")
+	RootCmd.PersistentFlags().StringVar(&flagProxyPassword, "proxy-password", "", "Proxy password")
+	RootCmd.PersistentFlags().StringVar(&flagSourceIP, "source-ip", "", "Local IP address to bind outgoing connections to")
+}
+
+// clientOptions returns the shared client options derived from global flags.
+func clientOptions() []base.Option {
+	level := slog.LevelDebug
+	switch {
+	case flagDebug:
+		level = base.LevelTrace
+	case !flagVerbose:
+		return nil
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// Name the header level instead of printing DEBUG-4
+			if a.Key == slog.LevelKey && a.Value.Any() == base.LevelTrace {
+				a.Value = slog.StringValue("TRACE")
+			}
+			return a
+		},
+	}))
+	return []base.Option{base.WithLogger(logger)}
 }
@@ -15,12 +15,25 @@ func init() { RootCmd.AddCommand(rriCmd) }
 func init() {
 	// Make common flags persistent so they apply to all subcommands under `rri`.
 	rriCmd.PersistentFlags().StringVar(&flagTLD, "tld", "", "TLD (e.g., example) [required unless in credentials]")
+	rriCmd.PersistentFlags().StringVar(&flagIANAID, "iana-id", "", "Registrar IANA ID (entity rr) [required unless in credentials]")
 	rriCmd.PersistentFlags().StringVar(&flagEnv, "env", "", "Environment: prod or ote")
-	rriCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic or tlsa")
-	rriCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic auth")
-	rriCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic auth")
+	rriCmd.PersistentFlags().StringVar(&flagAuth, "auth", "", "Auth type: basic, tlsa or session")
+	rriCmd.PersistentFlags().StringVar(&flagUser, "username", "", "Username for basic/session auth")
+	rriCmd.PersistentFlags().StringVar(&flagPass, "password", "", "Password for basic/session auth")
 	rriCmd.PersistentFlags().StringVar(&flagCertPEM, "cert-pem", "", "PEM-encoded client certificate for TLSA (string)")
 	rriCmd.PersistentFlags().StringVar(&flagKeyPEM, "key-pem", "", "PEM-encoded client key for TLSA (string)")
+	rriCmd.PersistentFlags().StringVar(&flagCertFile, "cert-file", "", "Path to PEM client certificate for TLSA")
+	rriCmd.PersistentFlags().StringVar(&flagKeyFile, "key-file", "", "Path to PEM client key for TLSA")
+	rriCmd.PersistentFlags().StringVar(&flagKeyPassphrase, "key-passphrase", "", "Passphrase for an encrypted TLSA client key")
+	rriCmd.PersistentFlags().StringVar(&flagP12File, "p12-file", "", "Path to PKCS#12 (.p12) bundle with client certificate and key for TLSA")
+	rriCmd.PersistentFlags().StringVar(&flagP12Password, "p12-password", "", "Password for the PKCS#12 bundle")
+	rriCmd.PersistentFlags().StringVar(&flagCAFile, "ca-file", "", "Path to PEM CA bundle used to verify the server")
 	rriCmd.PersistentFlags().StringVar(&flagVersion, "version", "", "API version (default v2)")
-	rriCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity (default ry)")
+	rriCmd.PersistentFlags().StringVar(&flagEntity, "entity", "", "Entity: ry (registry) or rr (registrar) (default ry)")
+	rriCmd.PersistentFlags().StringVar(&flagMOSAPIURL, "mosapi-url", "", "Override the MOSAPI base URL (e.g., a proxy or local mock)")
+	rriCmd.PersistentFlags().StringVar(&flagRRIURL, "rri-url", "", "Override the RRI base URL (e.g., a proxy or local mock)")
+	rriCmd.PersistentFlags().StringVar(&flagProxyURL, "proxy-url", "", "Proxy URL: http(s):// for HTTP CONNECT or socks5:// (default: environment proxy settings)")
+	rriCmd.PersistentFlags().StringVar(&flagProxyUsername, "proxy-username", "", "Proxy username")
+	rriCmd.PersistentFlags().StringVar(&flagProxyPassword, "proxy-password", "", "Proxy password")
+	rriCmd.PersistentFlags().StringVar(&flagSourceIP, "source-ip", "", "Local IP address to bind outgoing connections to")
 }
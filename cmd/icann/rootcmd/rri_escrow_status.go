@@ -3,7 +3,9 @@ package rootcmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/onasunnymorning/icann-client/rri"
@@ -11,7 +13,11 @@ import (
 )
 
 var (
-	flagDate string
+	flagDate        string
+	flagFrom        string
+	flagTo          string
+	flagConcurrency int
+	flagJSON        bool
 )
 
 var rriEscrowCmd = &cobra.Command{
@@ -21,10 +27,13 @@ var rriEscrowCmd = &cobra.Command{
 
 var rriEscrowStatusCmd = &cobra.Command{
 	Use:   "status",
-	Short: "Check Ry Escrow report status for a date",
+	Short: "Check Ry Escrow report status for a date or a date range",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagFrom != "" || flagTo != "" {
+			return escrowStatusRange(cmd)
+		}
 		if flagDate == "" {
-			return fmt.Errorf("--date is required (YYYY-MM-DD)")
+			return fmt.Errorf("--date (YYYY-MM-DD) or --from and --to are required")
 		}
 		dt, err := time.Parse("2006-01-02", flagDate)
 		if err != nil {
@@ -35,10 +44,11 @@ var rriEscrowStatusCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		cli, err := rri.New(cfg)
+		cli, err := rri.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 
 		out, err := cli.GetRyEscrowReportStatus(cmd.Context(), dt)
 		if err != nil {
@@ -50,9 +60,101 @@ var rriEscrowStatusCmd = &cobra.Command{
 	},
 }
 
+// escrowStatusRange checks every day from --from to --to and prints a calendar (or JSON).
+func escrowStatusRange(cmd *cobra.Command) error {
+	if flagDate != "" {
+		return fmt.Errorf("--date cannot be combined with --from/--to")
+	}
+	if flagFrom == "" || flagTo == "" {
+		return fmt.Errorf("--from and --to are both required (YYYY-MM-DD)")
+	}
+	from, err := time.Parse("2006-01-02", flagFrom)
+	if err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	}
+	to, err := time.Parse("2006-01-02", flagTo)
+	if err != nil {
+		return fmt.Errorf("invalid --to: %w", err)
+	}
+
+	cfg, err := buildConfigFromInputs()
+	if err != nil {
+		return err
+	}
+	cli, err := rri.New(cfg, clientOptions()...)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	out, err := cli.GetRyEscrowReportStatusRange(cmd.Context(), from, to, flagConcurrency)
+	if err != nil {
+		return err
+	}
+	if flagJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	printEscrowCalendar(os.Stdout, out)
+	return nil
+}
+
+// printEscrowCalendar prints one calendar per month of the range, weeks starting on
+// Monday, with days whose report is missing in brackets and days outside the range as dots.
+func printEscrowCalendar(w io.Writer, r *rri.EscrowStatusRange) {
+	fmt.Fprintf(w, "%s Ry Escrow %s to %s: %d received, %d missing\n", r.TLD,
+		r.From.Format("2006-01-02"), r.To.Format("2006-01-02"), r.Received(), len(r.Missing))
+	missing := make(map[time.Time]bool, len(r.Missing))
+	for _, d := range r.Missing {
+		missing[d] = true
+	}
+	for month := time.Date(r.From.Year(), r.From.Month(), 1, 0, 0, 0, 0, time.UTC); !month.After(r.To); month = month.AddDate(0, 1, 0) {
+		fmt.Fprintf(w, "\n%s\n", month.Format("January 2006"))
+		fmt.Fprintln(w, "  Mo  Tu  We  Th  Fr  Sa  Su")
+		// Only print the weeks overlapping the range, starting on Monday
+		start, end := month, month.AddDate(0, 1, -1)
+		if r.From.After(start) {
+			start = r.From
+		}
+		if r.To.Before(end) {
+			end = r.To
+		}
+		var line strings.Builder
+		for d := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7)); !d.After(end) || d.Weekday() != time.Monday; d = d.AddDate(0, 0, 1) {
+			cell := ""
+			switch {
+			case d.Month() != month.Month():
+			case d.Before(r.From) || d.After(r.To):
+				cell = "."
+			case missing[d]:
+				cell = fmt.Sprintf("[%d]", d.Day())
+			default:
+				cell = fmt.Sprint(d.Day())
+			}
+			fmt.Fprintf(&line, "%4s", cell)
+			if d.Weekday() == time.Sunday {
+				fmt.Fprintln(w, strings.TrimRight(line.String(), " "))
+				line.Reset()
+			}
+		}
+	}
+	if len(r.Missing) > 0 {
+		days := make([]string, len(r.Missing))
+		for i, d := range r.Missing {
+			days[i] = d.Format("2006-01-02")
+		}
+		fmt.Fprintf(w, "\nmissing: %s\n", strings.Join(days, ", "))
+	}
+}
+
 func init() {
 	getCmd.AddCommand(rriEscrowCmd)
 	rriEscrowCmd.AddCommand(rriEscrowStatusCmd)
 
 	rriEscrowStatusCmd.Flags().StringVar(&flagDate, "date", "", "Report date (YYYY-MM-DD)")
+	rriEscrowStatusCmd.Flags().StringVar(&flagFrom, "from", "", "First date of a range to audit (YYYY-MM-DD)")
+	rriEscrowStatusCmd.Flags().StringVar(&flagTo, "to", "", "Last date of a range to audit (YYYY-MM-DD)")
+	rriEscrowStatusCmd.Flags().IntVar(&flagConcurrency, "concurrency", rri.DefaultStatusConcurrency, "Days checked in parallel for --from/--to")
+	rriEscrowStatusCmd.Flags().BoolVar(&flagJSON, "json", false, "Print the --from/--to result as JSON instead of a calendar")
 }
@@ -0,0 +1,62 @@
+package rootcmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/onasunnymorning/icann-client/rri"
+)
+
+// escrowRange builds a range from..to (inclusive) with the given days missing.
+func escrowRange(from, to time.Time, missing ...time.Time) *rri.EscrowStatusRange {
+	r := &rri.EscrowStatusRange{TLD: "example", From: from, To: to, Missing: missing}
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		r.Days = append(r.Days, rri.EscrowDay{Date: d})
+	}
+	return r
+}
+
+func TestPrintEscrowCalendar(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name string
+		r    *rri.EscrowStatusRange
+		want string
+	}{
+		{"mid-week start and end across months", escrowRange(day(2026, 9, 16), day(2026, 10, 8), day(2026, 9, 17), day(2026, 10, 1)), `example Ry Escrow 2026-09-16 to 2026-10-08: 21 received, 2 missing
+
+September 2026
+  Mo  Tu  We  Th  Fr  Sa  Su
+   .   .  16[17]  18  19  20
+  21  22  23  24  25  26  27
+  28  29  30
+
+October 2026
+  Mo  Tu  We  Th  Fr  Sa  Su
+             [1]   2   3   4
+   5   6   7   8   .   .   .
+
+missing: 2026-09-17, 2026-10-01
+`},
+		{"single week across years", escrowRange(day(2026, 12, 30), day(2027, 1, 2)), `example Ry Escrow 2026-12-30 to 2027-01-02: 4 received, 0 missing
+
+December 2026
+  Mo  Tu  We  Th  Fr  Sa  Su
+   .   .  30  31
+
+January 2027
+  Mo  Tu  We  Th  Fr  Sa  Su
+                   1   2   .
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printEscrowCalendar(&buf, tt.r)
+			if got := buf.String(); got != tt.want {
+				t.Fatalf("calendar:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
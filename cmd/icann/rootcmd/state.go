@@ -2,18 +2,17 @@ package rootcmd
 
 import (
 	"encoding/json"
-	"fmt"
 	"os"
 	"strings"
 
 	base "github.com/onasunnymorning/icann-client/client"
-	"github.com/onasunnymorning/icann-client/cmd/icann/internal/cred"
 	"github.com/onasunnymorning/icann-client/mosapi"
 	"github.com/spf13/cobra"
 )
 
 var (
 	flagTLD     string
+	flagIANAID  string
 	flagEnv     string
 	flagAuth    string
 	flagUser    string
@@ -22,6 +21,21 @@ var (
 	flagKeyPEM  string
 	flagVersion string
 	flagEntity  string
+
+	flagCertFile      string
+	flagKeyFile       string
+	flagKeyPassphrase string
+	flagP12File       string
+	flagP12Password   string
+	flagCAFile        string
+
+	flagMOSAPIURL string
+	flagRRIURL    string
+
+	flagProxyURL      string
+	flagProxyUsername string
+	flagProxyPassword string
+	flagSourceIP      string
 )
 
 // stateCmd fetches MOSAPI monitoring state
@@ -29,49 +43,17 @@ var stateCmd = &cobra.Command{
 	Use:   "state",
 	Short: "Get MOSAPI monitoring state",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// Choose profile: explicit --profile, otherwise default to --tld if provided.
-		chosenProfile := profileFlag
-		if chosenProfile == "" && flagTLD != "" {
-			chosenProfile = flagTLD
-		}
-
-		// Load credentials from file/profile
-		rec, loadErr := cred.Load(chosenProfile, credentialsFileFlag)
-		if loadErr != nil && flagAuth == "" && flagUser == "" && flagPass == "" && flagCertPEM == "" && flagKeyPEM == "" {
-			return loadErr
-		}
-		if rec == nil {
-			rec = map[string]string{}
-		}
-
 		// Build config with precedence: flags > credentials file > defaults
-		cfg := base.Config{}
-		cfg.TLD = firstNonEmpty(flagTLD, rec["tld"], chosenProfile)
-		cfg.Environment = firstNonEmpty(flagEnv, rec["environment"], base.ENV_PROD)
-		cfg.Version = firstNonEmpty(flagVersion, rec["version"], base.V2)
-		cfg.Entity = firstNonEmpty(flagEntity, rec["entity"], base.EntityRegistry)
-		cfg.AuthType = deriveAuthType(flagAuth, rec)
-		switch cfg.AuthType {
-		case base.AUTH_TYPE_BASIC:
-			cfg.Username = firstNonEmpty(flagUser, rec["username"])
-			cfg.Password = firstNonEmpty(flagPass, rec["password"])
-		case base.AUTH_TYPE_TLSA:
-			// Prefer PEM values; support keys certificate_pem/key_pem; allow fallback to certificate/key if a caller still supplies them
-			cfg.CertificatePEM = expandEscapes(firstNonEmpty(flagCertPEM, rec["certificate_pem"], rec["certificate"]))
-			cfg.KeyPEM = expandEscapes(firstNonEmpty(flagKeyPEM, rec["key_pem"], rec["key"]))
-		}
-
-		if cfg.TLD == "" {
-			return fmt.Errorf("tld is required (provide --tld, credentials tld, or use a profile named after the TLD)")
-		}
-		if err := cfg.Validate(); err != nil {
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
 			return err
 		}
 
-		cli, err := mosapi.New(cfg)
+		cli, err := mosapi.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 		// Execute request
 		sr, err := cli.GetStateResponse(cmd.Context())
 		if err != nil {
@@ -102,19 +84,28 @@ func expandEscapes(s string) string {
 	return s
 }
 
+// tlsFlagsSet reports whether a TLS client certificate or key was given on the command line.
+func tlsFlagsSet() bool {
+	return flagCertPEM != "" || flagKeyPEM != "" || flagCertFile != "" || flagKeyFile != "" || flagP12File != ""
+}
+
 // deriveAuthType chooses the authentication type based on precedence:
 // 1) explicit flag (--auth)
-// 2) credentials file key (auth_type)
-// 3) presence of PEM fields implies TLSA
-// 4) default to BASIC
-func deriveAuthType(explicit string, rec map[string]string) string {
+// 2) certificate or key flags (tlsFlags) imply TLSA
+// 3) credentials file key (auth_type)
+// 4) presence of PEM or certificate file fields implies TLSA
+// 5) default to BASIC
+func deriveAuthType(explicit string, tlsFlags bool, rec map[string]string) string {
 	if explicit != "" {
 		return strings.ToLower(explicit)
 	}
+	if tlsFlags {
+		return base.AUTH_TYPE_TLSA
+	}
 	if v := strings.ToLower(rec["auth_type"]); v != "" {
 		return v
 	}
-	if rec["certificate_pem"] != "" || rec["key_pem"] != "" {
+	if rec["certificate_pem"] != "" || rec["key_pem"] != "" || rec["certificate_file"] != "" || rec["pkcs12_file"] != "" {
 		return base.AUTH_TYPE_TLSA
 	}
 	return base.AUTH_TYPE_BASIC
@@ -0,0 +1,32 @@
+package rootcmd
+
+import (
+	"testing"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestDeriveAuthType(t *testing.T) {
+	tests := []struct {
+		name     string
+		explicit string
+		tlsFlags bool
+		rec      map[string]string
+		want     string
+	}{
+		{"default", "", false, map[string]string{}, base.AUTH_TYPE_BASIC},
+		{"explicit wins", "Session", true, map[string]string{"auth_type": "tlsa"}, base.AUTH_TYPE_SESSION},
+		{"tls flags only", "", true, map[string]string{}, base.AUTH_TYPE_TLSA},
+		{"tls flags over profile", "", true, map[string]string{"auth_type": "basic"}, base.AUTH_TYPE_TLSA},
+		{"profile auth type", "", false, map[string]string{"auth_type": "basic", "certificate_file": "c"}, base.AUTH_TYPE_BASIC},
+		{"profile certificate file", "", false, map[string]string{"certificate_file": "c"}, base.AUTH_TYPE_TLSA},
+		{"profile pem", "", false, map[string]string{"certificate_pem": "c"}, base.AUTH_TYPE_TLSA},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deriveAuthType(tt.explicit, tt.tlsFlags, tt.rec); got != tt.want {
+				t.Fatalf("deriveAuthType = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
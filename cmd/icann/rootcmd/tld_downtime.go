@@ -0,0 +1,52 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/onasunnymorning/icann-client/mosapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagService string
+)
+
+var tldDowntimeCmd = &cobra.Command{
+	Use:   "downtime",
+	Short: "Get minutes of downtime for a service over the rolling week",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		service := strings.ToUpper(flagService)
+		if service == "" {
+			return fmt.Errorf("--service is required (DNS, DNSSEC, EPP, RDDS or RDAP)")
+		}
+		if err := base.ValidateService(service); err != nil {
+			return err
+		}
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		out, err := cli.GetServiceDowntime(cmd.Context(), service)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	},
+}
+
+func init() {
+	tldCmd.AddCommand(tldDowntimeCmd)
+
+	tldDowntimeCmd.Flags().StringVar(&flagService, "service", "", "Service: DNS, DNSSEC, EPP, RDDS or RDAP")
+}
@@ -0,0 +1,66 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/onasunnymorning/icann-client/mosapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagIncident string
+)
+
+var tldMeasurementsCmd = &cobra.Command{
+	Use:   "measurements",
+	Short: "Get the probe measurements of an incident",
+	Long: "Lists the measurements that contributed to an incident and fetches each measurement document,\n" +
+		"printing them as a JSON array with per-probe results, RTTs and error codes.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagIncident == "" {
+			return fmt.Errorf("--incident is required")
+		}
+		service := strings.ToUpper(flagService)
+		if service == "" {
+			return fmt.Errorf("--service is required (DNS, DNSSEC, EPP, RDDS or RDAP)")
+		}
+		if err := base.ValidateService(service); err != nil {
+			return err
+		}
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		list, err := cli.ListIncidentMeasurements(cmd.Context(), service, flagIncident)
+		if err != nil {
+			return err
+		}
+		out := make([]*mosapi.Measurement, 0, len(list.Measurements))
+		for _, m := range list.Measurements {
+			doc, err := cli.GetMeasurement(cmd.Context(), service, m.MeasurementID)
+			if err != nil {
+				return fmt.Errorf("measurement %s: %w", m.MeasurementID, err)
+			}
+			out = append(out, doc)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	},
+}
+
+func init() {
+	tldCmd.AddCommand(tldMeasurementsCmd)
+
+	tldMeasurementsCmd.Flags().StringVar(&flagIncident, "incident", "", "Incident ID")
+	tldMeasurementsCmd.Flags().StringVar(&flagService, "service", "", "Service: DNS, DNSSEC, EPP, RDDS or RDAP")
+}
@@ -0,0 +1,34 @@
+package rootcmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/onasunnymorning/icann-client/mosapi"
+	"github.com/spf13/cobra"
+)
+
+var tldProbesCmd = &cobra.Command{
+	Use:   "probes",
+	Short: "List SLA monitoring probe nodes and their status",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := buildConfigFromInputs()
+		if err != nil {
+			return err
+		}
+		cli, err := mosapi.New(cfg, clientOptions()...)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		out, err := cli.ListProbeNodes(cmd.Context())
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	},
+}
+
+func init() { tldCmd.AddCommand(tldProbesCmd) }
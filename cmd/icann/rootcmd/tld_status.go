@@ -16,10 +16,11 @@ var tldStatusCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		cli, err := mosapi.New(cfg)
+		cli, err := mosapi.New(cfg, clientOptions()...)
 		if err != nil {
 			return err
 		}
+		defer cli.Close()
 		sr, err := cli.GetStateResponse(cmd.Context())
 		if err != nil {
 			return err
@@ -0,0 +1,97 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+const (
+	// AlarmedYes indicates an alarm has been raised for the Service.
+	AlarmedYes = "Yes"
+	// AlarmedNo indicates no alarm has been raised for the Service.
+	AlarmedNo = "No"
+	// AlarmedDisabled indicates the Service is not being monitored.
+	AlarmedDisabled = "Disabled"
+)
+
+// AlarmedResponse represents the alarmed endpoint of a monitored Service.
+type AlarmedResponse struct {
+	Version         int   `json:"version"`
+	LastUpdateApiDb int64 `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	// Alarmed is one of AlarmedYes, AlarmedNo or AlarmedDisabled.
+	Alarmed string `json:"alarmed"`
+}
+
+// IsAlarmed reports whether an alarm has been raised for the Service.
+func (a AlarmedResponse) IsAlarmed() bool {
+	return a.Alarmed == AlarmedYes
+}
+
+// LastUpdatedTime returns LastUpdateApiDb as a UTC time.
+func (a AlarmedResponse) LastUpdatedTime() time.Time {
+	return time.Unix(a.LastUpdateApiDb, 0).UTC()
+}
+
+// GetServiceAlarmed reports whether ICANN has raised an alarm for the given service.
+// Service must be one of base.ServiceDNS, base.ServiceDNSSEC, base.ServiceEPP, base.ServiceRDDS
+// or base.ServiceRDAP (RDDS/RDAP only for registrars).
+func (c *Client) GetServiceAlarmed(ctx context.Context, service string) (*AlarmedResponse, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	// Per MOSAPI spec: /<entity>/<tld or IANA ID>/<version>/monitoring/<service>/alarmed (service in lower case)
+	ctx = base.WithOperation(ctx, "mosapi.GetServiceAlarmed")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/alarmed")
+	path := c.monitoringPath(service) + "/alarmed"
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out AlarmedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllServicesAlarmed fetches the monitoring state and checks the alarmed status of every
+// service listed in StateResponse.TestedServices. The result is keyed by service name.
+// Services this client does not know how to query are skipped.
+func (c *Client) GetAllServicesAlarmed(ctx context.Context) (map[string]*AlarmedResponse, error) {
+	sr, err := c.GetStateResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	services := make([]string, 0, len(sr.TestedServices))
+	for name := range sr.TestedServices {
+		services = append(services, name)
+	}
+	slices.Sort(services)
+
+	out := make(map[string]*AlarmedResponse, len(services))
+	for _, name := range services {
+		if c.validateService(name) != nil {
+			continue
+		}
+		a, err := c.GetServiceAlarmed(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("alarmed %s: %w", name, err)
+		}
+		out[name] = a
+	}
+	return out, nil
+}
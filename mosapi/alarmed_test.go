@@ -0,0 +1,69 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestGetServiceAlarmed_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/rdds/alarmed"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(AlarmedResponse{Version: 1, LastUpdateApiDb: 1422492450, Alarmed: AlarmedYes})
+	})
+
+	got, err := c.GetServiceAlarmed(context.Background(), base.ServiceRDDS)
+	if err != nil {
+		t.Fatalf("GetServiceAlarmed: %v", err)
+	}
+	if !got.IsAlarmed() {
+		t.Fatalf("expected alarmed, got %+v", got)
+	}
+}
+
+func TestGetAllServicesAlarmed(t *testing.T) {
+	alarmed := map[string]string{
+		"/ry/example/v2/monitoring/dns/alarmed":  AlarmedNo,
+		"/ry/example/v2/monitoring/epp/alarmed":  AlarmedYes,
+		"/ry/example/v2/monitoring/rdds/alarmed": AlarmedDisabled,
+	}
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/ry/example/v2/monitoring/state" {
+			json.NewEncoder(w).Encode(StateResponse{
+				TLD:    "example",
+				Status: "Down",
+				TestedServices: map[string]TestedService{
+					"DNS":     {Status: "Up"},
+					"EPP":     {Status: "Down"},
+					"RDDS":    {Status: "Disabled"},
+					"UNKNOWN": {Status: "Up"},
+				},
+			})
+			return
+		}
+		v, ok := alarmed[r.URL.Path]
+		if !ok {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(AlarmedResponse{Version: 1, Alarmed: v})
+	})
+
+	got, err := c.GetAllServicesAlarmed(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllServicesAlarmed: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("len = %d, want 3: %+v", len(got), got)
+	}
+	if got["DNS"].IsAlarmed() || !got["EPP"].IsAlarmed() || got["RDDS"].Alarmed != AlarmedDisabled {
+		t.Fatalf("unexpected result: DNS=%+v EPP=%+v RDDS=%+v", got["DNS"], got["EPP"], got["RDDS"])
+	}
+}
@@ -1,6 +1,9 @@
 package mosapi
 
 import (
+	"fmt"
+	"strings"
+
 	base "github.com/onasunnymorning/icann-client/client"
 )
 
@@ -13,10 +16,33 @@ type Client struct {
 
 // New creates a MOSAPI client using the shared configuration and auth. This
 // allows sharing credentials across MOSAPI and RRI clients.
-func New(cfg base.Config) (*Client, error) {
-	c, err := base.NewClient(cfg)
+// Options such as base.WithMiddleware customize the shared client.
+func New(cfg base.Config, opts ...base.Option) (*Client, error) {
+	c, err := base.NewClient(cfg, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &Client{Client: c}, nil
 }
+
+// IsRegistrar reports whether the client is configured for a registrar (Entity rr).
+func (c *Client) IsRegistrar() bool {
+	return c.Config().Entity == base.EntityRegistrar
+}
+
+// basePath returns the MOSAPI path prefix for the configured entity:
+// /<entity>/<tld or registrar IANA ID>/<version>
+func (c *Client) basePath() string {
+	cfg := c.Config()
+	return fmt.Sprintf("/%s/%s/%s", cfg.Entity, cfg.EntityID(), cfg.Version)
+}
+
+// monitoringPath returns the monitoring path of a service (in lower case per the MOSAPI spec).
+func (c *Client) monitoringPath(service string) string {
+	return c.basePath() + "/monitoring/" + strings.ToLower(service)
+}
+
+// validateService checks service against the services monitored for the configured entity.
+func (c *Client) validateService(service string) error {
+	return base.ValidateEntityService(c.Config().Entity, service)
+}
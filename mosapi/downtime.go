@@ -0,0 +1,59 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// DowntimeResponse represents the downtime endpoint of a monitored Service.
+type DowntimeResponse struct {
+	Version         int   `json:"version"`
+	LastUpdateApiDb int64 `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	// Downtime is the number of minutes of downtime accumulated by the Service
+	// during the last 7 days (i.e., rolling week).
+	Downtime int `json:"downtime"`
+}
+
+// LastUpdatedTime returns LastUpdateApiDb as a UTC time.
+func (d DowntimeResponse) LastUpdatedTime() time.Time {
+	return time.Unix(d.LastUpdateApiDb, 0).UTC()
+}
+
+// DowntimeDuration returns Downtime as a time.Duration.
+func (d DowntimeResponse) DowntimeDuration() time.Duration {
+	return time.Duration(d.Downtime) * time.Minute
+}
+
+// GetServiceDowntime fetches the minutes of downtime accumulated during the rolling
+// week for the given service. Service must be one of base.ServiceDNS, base.ServiceDNSSEC,
+// base.ServiceEPP, base.ServiceRDDS or base.ServiceRDAP (RDDS/RDAP only for registrars).
+func (c *Client) GetServiceDowntime(ctx context.Context, service string) (*DowntimeResponse, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	// Per MOSAPI spec: /<entity>/<tld or IANA ID>/<version>/monitoring/<service>/downtime (service in lower case)
+	ctx = base.WithOperation(ctx, "mosapi.GetServiceDowntime")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/downtime")
+	path := c.monitoringPath(service) + "/downtime"
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out DowntimeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,73 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestGetServiceDowntime_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/dns/downtime"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(DowntimeResponse{Version: 1, LastUpdateApiDb: 1422492450, Downtime: 10})
+	})
+
+	got, err := c.GetServiceDowntime(context.Background(), base.ServiceDNS)
+	if err != nil {
+		t.Fatalf("GetServiceDowntime: %v", err)
+	}
+	if got.Downtime != 10 || got.Version != 1 {
+		t.Fatalf("unexpected fields: %+v", got)
+	}
+	if got.DowntimeDuration() != 10*time.Minute {
+		t.Fatalf("DowntimeDuration = %s, want 10m", got.DowntimeDuration())
+	}
+}
+
+func TestGetServiceDowntime_InvalidService(t *testing.T) {
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	})
+
+	_, err := c.GetServiceDowntime(context.Background(), "FTP")
+	if !errors.Is(err, base.ErrUnsupportedService) {
+		t.Fatalf("err = %v, want ErrUnsupportedService", err)
+	}
+}
+
+func TestGetServiceDowntime_HTTPError(t *testing.T) {
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := c.GetServiceDowntime(context.Background(), base.ServiceRDDS)
+	var he *base.HTTPError
+	if !errors.As(err, &he) || he.StatusCode != http.StatusNotFound {
+		t.Fatalf("err = %v, want HTTPError 404", err)
+	}
+}
+
+func TestGetServiceDowntime_ErrorDocument(t *testing.T) {
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"resultCode": 2001, "message": "Unauthorized"}`))
+	})
+
+	_, err := c.GetServiceDowntime(context.Background(), base.ServiceDNS)
+	if !errors.Is(err, base.ErrUnauthorized) {
+		t.Fatalf("err = %v, want ErrUnauthorized", err)
+	}
+	var he *base.HTTPError
+	if !errors.As(err, &he) || he.ResultCode != 2001 || he.Message != "Unauthorized" {
+		t.Fatalf("err = %#v, want parsed error document", err)
+	}
+}
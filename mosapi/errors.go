@@ -0,0 +1,9 @@
+package mosapi
+
+import "fmt"
+
+var (
+	ErrMaintenanceEndBeforeStart = fmt.Errorf("maintenance window end must be after its start")
+	ErrMaintenanceStartInPast    = fmt.Errorf("maintenance window start must be in the future")
+	ErrMaintenanceIDRequired     = fmt.Errorf("maintenance window ID is required")
+)
@@ -3,7 +3,6 @@ package mosapi
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 
 	base "github.com/onasunnymorning/icann-client/client"
@@ -14,9 +13,10 @@ import (
 // GetStateResponse requests the MOSAPI monitoring state and returns the parsed response.
 // It uses the shared base client wiring (auth, base URL, timeouts).
 func (c *Client) GetStateResponse(ctx context.Context) (*StateResponse, error) {
-	cfg := c.Config()
 	// Build path per MOSAPI spec: /<entity>/<tld or registrar ID>/<version>/monitoring/state
-	path := fmt.Sprintf("/%s/%s/%s/monitoring/state", cfg.Entity, cfg.TLD, cfg.Version)
+	ctx = base.WithOperation(ctx, "mosapi.GetStateResponse")
+	ctx = base.WithEndpoint(ctx, "monitoring/state")
+	path := c.basePath() + "/monitoring/state"
 	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -28,7 +28,7 @@ func (c *Client) GetStateResponse(ctx context.Context) (*StateResponse, error) {
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		// Include the URL to aid debugging; return a typed HTTPError for programmatic handling.
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	var out StateResponse
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
@@ -0,0 +1,149 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// IncidentList represents the incidents of a Service over a time window.
+type IncidentList struct {
+	Version         int        `json:"version"`
+	LastUpdateApiDb int64      `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	Incidents       []Incident `json:"incidents"`
+}
+
+// IncidentResponse represents a single incident as returned by the incident detail endpoint.
+type IncidentResponse struct {
+	Version         int   `json:"version"`
+	LastUpdateApiDb int64 `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	Incident
+}
+
+// IncidentStateHistory represents the state transitions of an incident.
+type IncidentStateHistory struct {
+	Version         int                   `json:"version"`
+	LastUpdateApiDb int64                 `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	IncidentID      string                `json:"incidentID"`
+	States          []IncidentStateChange `json:"states"`
+}
+
+// IncidentStateChange is a single entry in an IncidentStateHistory.
+type IncidentStateChange struct {
+	State IncidentState `json:"state"`
+	// FalsePositive is true once ICANN has flagged the incident as a false positive.
+	FalsePositive bool  `json:"falsePositive"`
+	Time          int64 `json:"time"` // Unix timestamp seconds of the transition.
+}
+
+// TimeTime returns the transition time as a UTC time.
+func (s IncidentStateChange) TimeTime() time.Time {
+	return time.Unix(s.Time, 0).UTC()
+}
+
+// Current returns the most recent state change, or false if the history is empty.
+func (h IncidentStateHistory) Current() (IncidentStateChange, bool) {
+	if len(h.States) == 0 {
+		return IncidentStateChange{}, false
+	}
+	return h.States[len(h.States)-1], true
+}
+
+// ListIncidents lists the incidents of the given service, optionally filtered by a start and end
+// time. Zero times are omitted from the query.
+func (c *Client) ListIncidents(ctx context.Context, service string, start, end time.Time) (*IncidentList, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.ListIncidents")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/incidents")
+	basePath := c.monitoringPath(service) + "/incidents"
+	u, _ := url.Parse(basePath)
+	q := u.Query()
+	if !start.IsZero() {
+		q.Set("startDate", strconv.FormatInt(start.Unix(), 10))
+	}
+	if !end.IsZero() {
+		q.Set("endDate", strconv.FormatInt(end.Unix(), 10))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := c.NewRequest(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out IncidentList
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetIncident fetches a single incident of the given service by its IncidentID.
+func (c *Client) GetIncident(ctx context.Context, service, incidentID string) (*IncidentResponse, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.GetIncident")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/incidents/{incidentID}")
+	path := fmt.Sprintf("%s/incidents/%s", c.monitoringPath(service), url.PathEscape(incidentID))
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out IncidentResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetIncidentStateHistory fetches the state transitions (active, resolved, false positive)
+// of a single incident of the given service.
+func (c *Client) GetIncidentStateHistory(ctx context.Context, service, incidentID string) (*IncidentStateHistory, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.GetIncidentStateHistory")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/incidents/{incidentID}/state")
+	path := fmt.Sprintf("%s/incidents/%s/state", c.monitoringPath(service), url.PathEscape(incidentID))
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out IncidentStateHistory
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,134 @@
+package mosapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestListIncidents_PathQueryAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/dns/incidents"
+	wantQ := "endDate=1700003600&startDate=1700000000"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		if r.URL.RawQuery != wantQ {
+			t.Fatalf("query = %s, want %s", r.URL.RawQuery, wantQ)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version":1,"lastUpdateApiDatabase":1700003600,"incidents":[
+			{"incidentID":"1495811850.1700","startTime":1700000100,"falsePositive":false,"state":"Active","endTime":null},
+			{"incidentID":"1495811850.1699","startTime":1700000000,"falsePositive":true,"state":"Resolved","endTime":1700000050}
+		]}`))
+	})
+
+	got, err := c.ListIncidents(context.Background(), base.ServiceDNS, time.Unix(1700000000, 0), time.Unix(1700003600, 0))
+	if err != nil {
+		t.Fatalf("ListIncidents: %v", err)
+	}
+	if len(got.Incidents) != 2 {
+		t.Fatalf("len = %d, want 2", len(got.Incidents))
+	}
+	if !got.Incidents[0].IsActive() || got.Incidents[0].EndTimeTime() != nil {
+		t.Fatalf("unexpected first incident: %+v", got.Incidents[0])
+	}
+	if got.Incidents[1].State != IncidentResolved || !got.Incidents[1].FalsePositive {
+		t.Fatalf("unexpected second incident: %+v", got.Incidents[1])
+	}
+}
+
+func TestListIncidents_NoFilters(t *testing.T) {
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"version":1,"incidents":[]}`))
+	})
+
+	if _, err := c.ListIncidents(context.Background(), base.ServiceEPP, time.Time{}, time.Time{}); err != nil {
+		t.Fatalf("ListIncidents: %v", err)
+	}
+}
+
+func TestGetIncident_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/rdds/incidents/1495811850.1700"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"version":1,"lastUpdateApiDatabase":1700003600,"incidentID":"1495811850.1700","startTime":1700000100,"falsePositive":false,"state":"Resolved","endTime":1700000200}`))
+	})
+
+	got, err := c.GetIncident(context.Background(), base.ServiceRDDS, "1495811850.1700")
+	if err != nil {
+		t.Fatalf("GetIncident: %v", err)
+	}
+	if got.IncidentID != "1495811850.1700" || got.State != IncidentResolved || got.EndTimeTime() == nil {
+		t.Fatalf("unexpected incident: %+v", got)
+	}
+}
+
+func TestGetIncidentStateHistory_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/dns/incidents/1495811850.1700/state"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"version":1,"incidentID":"1495811850.1700","states":[
+			{"state":"Active","falsePositive":false,"time":1700000100},
+			{"state":"Resolved","falsePositive":false,"time":1700000200},
+			{"state":"Resolved","falsePositive":true,"time":1700000300}
+		]}`))
+	})
+
+	got, err := c.GetIncidentStateHistory(context.Background(), base.ServiceDNS, "1495811850.1700")
+	if err != nil {
+		t.Fatalf("GetIncidentStateHistory: %v", err)
+	}
+	cur, ok := got.Current()
+	if !ok || cur.State != IncidentResolved || !cur.FalsePositive {
+		t.Fatalf("unexpected current state: %+v", cur)
+	}
+	for _, s := range got.States {
+		if !s.State.IsValid() {
+			t.Fatalf("invalid state %q", s.State)
+		}
+	}
+}
+
+func TestGetIncident_MetricsEndpointTemplate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"incidentID":"1700000000.42"}`))
+	}))
+	defer srv.Close()
+	reg := prometheus.NewRegistry()
+	m, err := base.NewMetrics(reg)
+	if err != nil {
+		t.Fatalf("NewMetrics: %v", err)
+	}
+	cfg := base.Config{TLD: "example", AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p", MOSAPIURL: srv.URL}
+	c, err := New(cfg, base.WithMetrics(m))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := c.GetIncident(context.Background(), base.ServiceDNS, "1700000000.42"); err != nil {
+		t.Fatalf("GetIncident: %v", err)
+	}
+	// The incident ID must not leak into the endpoint label
+	want := `
+# HELP icann_client_requests_total ICANN API calls by API, endpoint template, TLD and status code.
+# TYPE icann_client_requests_total counter
+icann_client_requests_total{api="mosapi",code="200",endpoint="monitoring/{service}/incidents/{incidentID}",tld="example"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "icann_client_requests_total"); err != nil {
+		t.Fatal(err)
+	}
+}
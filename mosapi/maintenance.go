@@ -0,0 +1,125 @@
+package mosapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// MaintenanceWindow represents a scheduled maintenance of a monitored Service notified to ICANN.
+type MaintenanceWindow struct {
+	// ID is assigned by MOSAPI when the window is created.
+	ID          string `json:"id,omitempty"`
+	Service     string `json:"service"`
+	StartTime   int64  `json:"startTime"` // Unix timestamp seconds.
+	EndTime     int64  `json:"endTime"`   // Unix timestamp seconds.
+	Description string `json:"description,omitempty"`
+}
+
+// MaintenanceWindowList represents the maintenance windows scheduled for the TLD.
+type MaintenanceWindowList struct {
+	Version            int                 `json:"version"`
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows"`
+}
+
+// NewMaintenanceWindow builds a MaintenanceWindow for service between start and end.
+func NewMaintenanceWindow(service string, start, end time.Time) MaintenanceWindow {
+	return MaintenanceWindow{Service: service, StartTime: start.Unix(), EndTime: end.Unix()}
+}
+
+// StartTimeTime returns the window start as a UTC time.
+func (w MaintenanceWindow) StartTimeTime() time.Time {
+	return time.Unix(w.StartTime, 0).UTC()
+}
+
+// EndTimeTime returns the window end as a UTC time.
+func (w MaintenanceWindow) EndTimeTime() time.Time {
+	return time.Unix(w.EndTime, 0).UTC()
+}
+
+// Validate checks that the window targets a known service, starts after now and ends after it starts.
+// CreateMaintenanceWindow additionally restricts the service to those monitored for the
+// client's entity (see base.ValidateEntityService).
+func (w MaintenanceWindow) Validate(now time.Time) error {
+	if err := base.ValidateService(w.Service); err != nil {
+		return err
+	}
+	if w.EndTime <= w.StartTime {
+		return ErrMaintenanceEndBeforeStart
+	}
+	if w.StartTime <= now.Unix() {
+		return ErrMaintenanceStartInPast
+	}
+	return nil
+}
+
+func (c *Client) maintenancePath() string {
+	return c.basePath() + "/maintenance"
+}
+
+// CreateMaintenanceWindow validates w and notifies ICANN of the maintenance window.
+// It returns the window as stored by MOSAPI, including its ID.
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, w MaintenanceWindow) (*MaintenanceWindow, error) {
+	if err := c.validateService(w.Service); err != nil {
+		return nil, err
+	}
+	if err := w.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+	var out MaintenanceWindow
+	ctx = base.WithOperation(ctx, "mosapi.CreateMaintenanceWindow")
+	ctx = base.WithEndpoint(ctx, "maintenance")
+	resp, err := c.DoJSON(ctx, http.MethodPost, c.maintenancePath(), w, &out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &out, nil
+}
+
+// ListMaintenanceWindows lists the maintenance windows scheduled for the TLD.
+func (c *Client) ListMaintenanceWindows(ctx context.Context) (*MaintenanceWindowList, error) {
+	var out MaintenanceWindowList
+	ctx = base.WithOperation(ctx, "mosapi.ListMaintenanceWindows")
+	ctx = base.WithEndpoint(ctx, "maintenance")
+	resp, err := c.DoJSON(ctx, http.MethodGet, c.maintenancePath(), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &out, nil
+}
+
+// GetMaintenanceWindow fetches a single maintenance window by ID.
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	if id == "" {
+		return nil, ErrMaintenanceIDRequired
+	}
+	var out MaintenanceWindow
+	ctx = base.WithOperation(ctx, "mosapi.GetMaintenanceWindow")
+	ctx = base.WithEndpoint(ctx, "maintenance/{id}")
+	resp, err := c.DoJSON(ctx, http.MethodGet, c.maintenancePath()+"/"+url.PathEscape(id), nil, &out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &out, nil
+}
+
+// DeleteMaintenanceWindow cancels a maintenance window by ID.
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id string) error {
+	if id == "" {
+		return ErrMaintenanceIDRequired
+	}
+	ctx = base.WithOperation(ctx, "mosapi.DeleteMaintenanceWindow")
+	ctx = base.WithEndpoint(ctx, "maintenance/{id}")
+	resp, err := c.DoJSON(ctx, http.MethodDelete, c.maintenancePath()+"/"+url.PathEscape(id), nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,134 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestMaintenanceWindow_Validate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		wantErr error
+	}{
+		{
+			name:    "valid",
+			window:  NewMaintenanceWindow(base.ServiceDNS, now.Add(time.Hour), now.Add(2*time.Hour)),
+			wantErr: nil,
+		},
+		{
+			name:    "unknown service",
+			window:  NewMaintenanceWindow("FTP", now.Add(time.Hour), now.Add(2*time.Hour)),
+			wantErr: base.ErrUnsupportedService,
+		},
+		{
+			name:    "end before start",
+			window:  NewMaintenanceWindow(base.ServiceDNS, now.Add(2*time.Hour), now.Add(time.Hour)),
+			wantErr: ErrMaintenanceEndBeforeStart,
+		},
+		{
+			name:    "start in the past",
+			window:  NewMaintenanceWindow(base.ServiceDNS, now.Add(-time.Hour), now.Add(time.Hour)),
+			wantErr: ErrMaintenanceStartInPast,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.window.Validate(now); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateMaintenanceWindow(t *testing.T) {
+	wantPath := "/ry/example/v2/maintenance"
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != wantPath {
+			t.Fatalf("request = %s %s, want POST %s", r.Method, r.URL.Path, wantPath)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Fatalf("Content-Type = %q", ct)
+		}
+		var in MaintenanceWindow
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if in.Service != base.ServiceDNS || in.StartTime != start.Unix() {
+			t.Fatalf("unexpected body: %+v", in)
+		}
+		in.ID = "mw-1"
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(in)
+	})
+
+	got, err := c.CreateMaintenanceWindow(context.Background(), NewMaintenanceWindow(base.ServiceDNS, start, start.Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("CreateMaintenanceWindow: %v", err)
+	}
+	if got.ID != "mw-1" || !got.StartTimeTime().Equal(start) {
+		t.Fatalf("unexpected window: %+v", got)
+	}
+}
+
+func TestCreateMaintenanceWindow_InvalidIsNotSent(t *testing.T) {
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	})
+	start := time.Now().Add(time.Hour)
+	_, err := c.CreateMaintenanceWindow(context.Background(), NewMaintenanceWindow(base.ServiceDNS, start, start.Add(-time.Minute)))
+	if !errors.Is(err, ErrMaintenanceEndBeforeStart) {
+		t.Fatalf("err = %v, want ErrMaintenanceEndBeforeStart", err)
+	}
+}
+
+func TestListGetDeleteMaintenanceWindows(t *testing.T) {
+	var deleted bool
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ry/example/v2/maintenance":
+			w.Write([]byte(`{"version":1,"maintenanceWindows":[{"id":"mw-1","service":"DNS","startTime":1900000000,"endTime":1900003600}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/ry/example/v2/maintenance/mw-1":
+			w.Write([]byte(`{"id":"mw-1","service":"DNS","startTime":1900000000,"endTime":1900003600}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/ry/example/v2/maintenance/mw-1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+
+	list, err := c.ListMaintenanceWindows(ctx)
+	if err != nil {
+		t.Fatalf("ListMaintenanceWindows: %v", err)
+	}
+	if len(list.MaintenanceWindows) != 1 || list.MaintenanceWindows[0].ID != "mw-1" {
+		t.Fatalf("unexpected list: %+v", list)
+	}
+	mw, err := c.GetMaintenanceWindow(ctx, "mw-1")
+	if err != nil {
+		t.Fatalf("GetMaintenanceWindow: %v", err)
+	}
+	if mw.EndTime-mw.StartTime != 3600 {
+		t.Fatalf("unexpected window: %+v", mw)
+	}
+	if err := c.DeleteMaintenanceWindow(ctx, "mw-1"); err != nil || !deleted {
+		t.Fatalf("DeleteMaintenanceWindow: err=%v deleted=%v", err, deleted)
+	}
+	var he *base.HTTPError
+	if err := c.DeleteMaintenanceWindow(ctx, "mw-2"); !errors.As(err, &he) || he.StatusCode != http.StatusNotFound {
+		t.Fatalf("DeleteMaintenanceWindow(mw-2) err = %v, want HTTPError 404", err)
+	}
+}
@@ -0,0 +1,179 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// MeasurementResultOK is the Result of a ProbeMetric that succeeded. Any other value
+// is a negative error code (e.g. "-200" for "no reply from name server").
+const MeasurementResultOK = "ok"
+
+// MeasurementList represents the measurements available for an incident or time range.
+type MeasurementList struct {
+	Version         int               `json:"version"`
+	LastUpdateApiDb int64             `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	Measurements    []MeasurementInfo `json:"measurements"`
+}
+
+// MeasurementInfo contains basic metadata for a measurement.
+type MeasurementInfo struct {
+	MeasurementID   string `json:"measurementID"`
+	MeasurementTime int64  `json:"measurementTime"` // Unix timestamp seconds of the measurement cycle.
+}
+
+// Measurement is a measurement document: the per-probe results of a single measurement cycle.
+type Measurement struct {
+	Version                  int                    `json:"version"`
+	LastUpdateApiDb          int64                  `json:"lastUpdateApiDatabase"`
+	TLD                      string                 `json:"tld"`
+	Service                  string                 `json:"service"`
+	CycleCalculationDateTime int64                  `json:"cycleCalculationDateTime"` // Unix timestamp seconds of the cycle.
+	Status                   string                 `json:"status"`
+	TestedInterfaces         []MeasurementInterface `json:"testedInterface"`
+}
+
+// MeasurementInterface groups probe results by tested interface (e.g. DNS, RDDS43, RDDS80, RDAP).
+type MeasurementInterface struct {
+	Interface string        `json:"interface"`
+	Status    string        `json:"status"`
+	Probes    []ProbeResult `json:"probes"`
+}
+
+// ProbeResult holds the results of a single probe node.
+type ProbeResult struct {
+	City     string          `json:"city"`
+	Status   string          `json:"status"`
+	TestData []ProbeTestData `json:"testData"`
+}
+
+// ProbeTestData holds the metrics of a probe against a single target (e.g. a name server).
+type ProbeTestData struct {
+	Target  string        `json:"target"`
+	Status  string        `json:"status"`
+	Metrics []ProbeMetric `json:"metrics"`
+}
+
+// ProbeMetric is a single test performed by a probe.
+type ProbeMetric struct {
+	TestDateTime int64  `json:"testDateTime"` // Unix timestamp seconds of the test.
+	TargetIP     string `json:"targetIP"`
+	// RTT is the round trip time in milliseconds; nil when the test failed.
+	RTT *int `json:"rtt"`
+	// Result is MeasurementResultOK or a negative error code.
+	Result string `json:"result"`
+}
+
+// CycleTime returns CycleCalculationDateTime as a UTC time.
+func (m Measurement) CycleTime() time.Time {
+	return time.Unix(m.CycleCalculationDateTime, 0).UTC()
+}
+
+// IsOK reports whether the test succeeded.
+func (p ProbeMetric) IsOK() bool {
+	return p.Result == MeasurementResultOK
+}
+
+// ErrorCode returns the numeric error code of a failed test, or false if the test succeeded
+// or the result is not numeric.
+func (p ProbeMetric) ErrorCode() (int, bool) {
+	if p.IsOK() {
+		return 0, false
+	}
+	code, err := strconv.Atoi(p.Result)
+	if err != nil {
+		return 0, false
+	}
+	return code, true
+}
+
+// TestTime returns TestDateTime as a UTC time.
+func (p ProbeMetric) TestTime() time.Time {
+	return time.Unix(p.TestDateTime, 0).UTC()
+}
+
+// ListIncidentMeasurements lists the measurements that contributed to an incident of the given service.
+func (c *Client) ListIncidentMeasurements(ctx context.Context, service, incidentID string) (*MeasurementList, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.ListIncidentMeasurements")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/incidents/{incidentID}/measurements")
+	path := fmt.Sprintf("%s/incidents/%s/measurements", c.monitoringPath(service), url.PathEscape(incidentID))
+	return c.listMeasurements(ctx, path)
+}
+
+// ListMeasurements lists the measurements of the given service, optionally filtered by a start
+// and end time. Zero times are omitted from the query.
+func (c *Client) ListMeasurements(ctx context.Context, service string, start, end time.Time) (*MeasurementList, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.ListMeasurements")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/measurements")
+	basePath := c.monitoringPath(service) + "/measurements"
+	u, _ := url.Parse(basePath)
+	q := u.Query()
+	if !start.IsZero() {
+		q.Set("startDate", strconv.FormatInt(start.Unix(), 10))
+	}
+	if !end.IsZero() {
+		q.Set("endDate", strconv.FormatInt(end.Unix(), 10))
+	}
+	u.RawQuery = q.Encode()
+	return c.listMeasurements(ctx, u.String())
+}
+
+func (c *Client) listMeasurements(ctx context.Context, path string) (*MeasurementList, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out MeasurementList
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMeasurement fetches a single measurement document of the given service.
+func (c *Client) GetMeasurement(ctx context.Context, service, measurementID string) (*Measurement, error) {
+	if err := c.validateService(service); err != nil {
+		return nil, err
+	}
+	ctx = base.WithOperation(ctx, "mosapi.GetMeasurement")
+	ctx = base.WithEndpoint(ctx, "monitoring/{service}/measurements/{measurementID}")
+	path := fmt.Sprintf("%s/measurements/%s", c.monitoringPath(service), url.PathEscape(measurementID))
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out Measurement
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,79 @@
+package mosapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestListIncidentMeasurements_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/dns/incidents/1495811850.1700/measurements"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"version":1,"lastUpdateApiDatabase":1700003600,"measurements":[
+			{"measurementID":"1700000100.1","measurementTime":1700000100},
+			{"measurementID":"1700000160.1","measurementTime":1700000160}
+		]}`))
+	})
+
+	got, err := c.ListIncidentMeasurements(context.Background(), base.ServiceDNS, "1495811850.1700")
+	if err != nil {
+		t.Fatalf("ListIncidentMeasurements: %v", err)
+	}
+	if len(got.Measurements) != 2 || got.Measurements[1].MeasurementID != "1700000160.1" {
+		t.Fatalf("unexpected measurements: %+v", got.Measurements)
+	}
+}
+
+func TestListMeasurements_Query(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/rdds/measurements"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath || r.URL.RawQuery != "startDate=1700000000" {
+			t.Fatalf("path/query = %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"version":1,"measurements":[]}`))
+	})
+
+	if _, err := c.ListMeasurements(context.Background(), base.ServiceRDDS, time.Unix(1700000000, 0), time.Time{}); err != nil {
+		t.Fatalf("ListMeasurements: %v", err)
+	}
+}
+
+func TestGetMeasurement_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/dns/measurements/1700000100.1"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"version":1,"tld":"example","service":"DNS","cycleCalculationDateTime":1700000100,"status":"Down",
+			"testedInterface":[{"interface":"DNS","status":"Down","probes":[
+				{"city":"Los Angeles","status":"Down","testData":[{"target":"ns1.example","status":"Down","metrics":[
+					{"testDateTime":1700000101,"targetIP":"192.0.2.1","rtt":null,"result":"-200"},
+					{"testDateTime":1700000102,"targetIP":"2001:db8::1","rtt":34,"result":"ok"}
+				]}]}
+			]}]}`))
+	})
+
+	got, err := c.GetMeasurement(context.Background(), base.ServiceDNS, "1700000100.1")
+	if err != nil {
+		t.Fatalf("GetMeasurement: %v", err)
+	}
+	if got.Service != "DNS" || got.CycleTime() != time.Unix(1700000100, 0).UTC() {
+		t.Fatalf("unexpected measurement: %+v", got)
+	}
+	metrics := got.TestedInterfaces[0].Probes[0].TestData[0].Metrics
+	if code, ok := metrics[0].ErrorCode(); !ok || code != -200 || metrics[0].RTT != nil {
+		t.Fatalf("unexpected failed metric: %+v", metrics[0])
+	}
+	if !metrics[1].IsOK() || metrics[1].RTT == nil || *metrics[1].RTT != 34 {
+		t.Fatalf("unexpected ok metric: %+v", metrics[1])
+	}
+	if _, ok := metrics[1].ErrorCode(); ok {
+		t.Fatalf("ErrorCode on ok metric should report false")
+	}
+}
@@ -46,8 +46,9 @@ type MetricaListInfo struct {
 
 // GetMetricaLatest fetches the latest METRICA domain list report.
 func (c *Client) GetMetricaLatest(ctx context.Context) (*MetricaDomainListLatest, error) {
-	cfg := c.Config()
-	path := fmt.Sprintf("/%s/%s/%s/metrica/domainList/latest", cfg.Entity, cfg.TLD, cfg.Version)
+	ctx = base.WithOperation(ctx, "mosapi.GetMetricaLatest")
+	ctx = base.WithEndpoint(ctx, "metrica/domainList/latest")
+	path := c.basePath() + "/metrica/domainList/latest"
 	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -58,10 +59,10 @@ func (c *Client) GetMetricaLatest(ctx context.Context) (*MetricaDomainListLatest
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	var out MetricaDomainListLatest
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
@@ -73,8 +74,9 @@ func (c *Client) GetMetricaLatest(ctx context.Context) (*MetricaDomainListLatest
 
 // GetMetricaByDate fetches a METRICA report for a specific date (YYYY-MM-DD).
 func (c *Client) GetMetricaByDate(ctx context.Context, date string) (*MetricaDomainListLatest, error) {
-	cfg := c.Config()
-	path := fmt.Sprintf("/%s/%s/%s/metrica/domainList/%s", cfg.Entity, cfg.TLD, cfg.Version, url.PathEscape(date))
+	ctx = base.WithOperation(ctx, "mosapi.GetMetricaByDate")
+	ctx = base.WithEndpoint(ctx, "metrica/domainList/{date}")
+	path := fmt.Sprintf("%s/metrica/domainList/%s", c.basePath(), url.PathEscape(date))
 	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -85,10 +87,10 @@ func (c *Client) GetMetricaByDate(ctx context.Context, date string) (*MetricaDom
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	var out MetricaDomainListLatest
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
@@ -100,8 +102,9 @@ func (c *Client) GetMetricaByDate(ctx context.Context, date string) (*MetricaDom
 
 // ListMetricaReports lists available METRICA reports, optionally filtered by startDate and endDate (YYYY-MM-DD).
 func (c *Client) ListMetricaReports(ctx context.Context, startDate, endDate string) (*MetricaDomainLists, error) {
-	cfg := c.Config()
-	basePath := fmt.Sprintf("/%s/%s/%s/metrica/domainLists", cfg.Entity, cfg.TLD, cfg.Version)
+	ctx = base.WithOperation(ctx, "mosapi.ListMetricaReports")
+	ctx = base.WithEndpoint(ctx, "metrica/domainLists")
+	basePath := c.basePath() + "/metrica/domainLists"
 	// Build query parameters if provided
 	u, _ := url.Parse(basePath)
 	q := u.Query()
@@ -123,7 +126,7 @@ func (c *Client) ListMetricaReports(ctx context.Context, startDate, endDate stri
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 	var out MetricaDomainLists
 	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
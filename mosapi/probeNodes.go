@@ -0,0 +1,79 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+const (
+	// ProbeNodeOnline indicates the probe node is online and taking measurements.
+	ProbeNodeOnline = "Online"
+	// ProbeNodeOffline indicates the probe node is offline.
+	ProbeNodeOffline = "Offline"
+)
+
+// ProbeNodeList represents the probe nodes of the SLA monitoring system.
+type ProbeNodeList struct {
+	Version         int         `json:"version"`
+	LastUpdateApiDb int64       `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
+	ProbeNodes      []ProbeNode `json:"probeNodes"`
+}
+
+// ProbeNode is a single probe node of the SLA monitoring system.
+type ProbeNode struct {
+	Name     string `json:"name"`
+	Location string `json:"location"`
+	// Status is ProbeNodeOnline or ProbeNodeOffline.
+	Status     string `json:"status"`
+	LastUpdate int64  `json:"lastUpdate"` // Unix timestamp seconds of the last status change.
+}
+
+// IsOnline reports whether the probe node is online.
+func (p ProbeNode) IsOnline() bool {
+	return p.Status == ProbeNodeOnline
+}
+
+// LastUpdateTime returns LastUpdate as a UTC time.
+func (p ProbeNode) LastUpdateTime() time.Time {
+	return time.Unix(p.LastUpdate, 0).UTC()
+}
+
+// Online returns the probe nodes that are currently online.
+func (l ProbeNodeList) Online() []ProbeNode {
+	var out []ProbeNode
+	for _, p := range l.ProbeNodes {
+		if p.IsOnline() {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// ListProbeNodes lists the probe nodes of the SLA monitoring system and their status. It can be
+// used to correlate UP-inconclusive-no-probes states with probe outages.
+func (c *Client) ListProbeNodes(ctx context.Context) (*ProbeNodeList, error) {
+	ctx = base.WithOperation(ctx, "mosapi.ListProbeNodes")
+	ctx = base.WithEndpoint(ctx, "monitoring/probeNodes")
+	path := c.basePath() + "/monitoring/probeNodes"
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, base.NewHTTPError(req, resp)
+	}
+	var out ProbeNodeList
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,35 @@
+package mosapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestListProbeNodes_PathAndDecode(t *testing.T) {
+	wantPath := "/ry/example/v2/monitoring/probeNodes"
+	c := newTestMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"version":1,"lastUpdateApiDatabase":1700003600,"probeNodes":[
+			{"name":"probe-lax","location":"Los Angeles, US","status":"Online","lastUpdate":1700000000},
+			{"name":"probe-ams","location":"Amsterdam, NL","status":"Offline","lastUpdate":1700001000}
+		]}`))
+	})
+
+	got, err := c.ListProbeNodes(context.Background())
+	if err != nil {
+		t.Fatalf("ListProbeNodes: %v", err)
+	}
+	if len(got.ProbeNodes) != 2 {
+		t.Fatalf("len = %d, want 2", len(got.ProbeNodes))
+	}
+	online := got.Online()
+	if len(online) != 1 || online[0].Name != "probe-lax" {
+		t.Fatalf("unexpected online nodes: %+v", online)
+	}
+	if got.ProbeNodes[1].IsOnline() || got.ProbeNodes[1].LastUpdateTime().Unix() != 1700001000 {
+		t.Fatalf("unexpected offline node: %+v", got.ProbeNodes[1])
+	}
+}
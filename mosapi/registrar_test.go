@@ -0,0 +1,82 @@
+package mosapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func newTestRegistrarMOSAPI(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(srv.Close)
+
+	cfg := base.Config{IANAID: 9999, Environment: base.ENV_PROD, Version: base.V2, Entity: base.EntityRegistrar, AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p"}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if err := c.WithBaseURL(srv.URL); err != nil {
+		t.Fatalf("with base url: %v", err)
+	}
+	return c
+}
+
+func TestRegistrar_GetStateResponse(t *testing.T) {
+	wantPath := "/rr/9999/v2/monitoring/state"
+	c := newTestRegistrarMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		w.Write([]byte(`{"ianaId":9999,"lastUpdateApiDatabase":1700000000,"status":"Up","version":2,
+			"testedServices":{"RDDS":{"status":"Up","emergencyThreshold":0,"incidents":[]},"RDAP":{"status":"Up","emergencyThreshold":0,"incidents":[]}}}`))
+	})
+
+	if !c.IsRegistrar() {
+		t.Fatalf("expected registrar client")
+	}
+	got, err := c.GetStateResponse(context.Background())
+	if err != nil {
+		t.Fatalf("GetStateResponse: %v", err)
+	}
+	if got.IANAID != 9999 || got.TLD != "" || len(got.TestedServices) != 2 || !got.AllServicesUp() {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestRegistrar_ServicePathsAndValidation(t *testing.T) {
+	wantPath := "/rr/9999/v2/monitoring/rdap/downtime"
+	c := newTestRegistrarMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Fatalf("path = %s, want %s", r.URL.Path, wantPath)
+		}
+		json.NewEncoder(w).Encode(DowntimeResponse{Version: 1, Downtime: 3})
+	})
+
+	got, err := c.GetServiceDowntime(context.Background(), base.ServiceRDAP)
+	if err != nil {
+		t.Fatalf("GetServiceDowntime: %v", err)
+	}
+	if got.Downtime != 3 {
+		t.Fatalf("unexpected downtime: %+v", got)
+	}
+	if _, err := c.GetServiceAlarmed(context.Background(), base.ServiceDNS); !errors.Is(err, base.ErrRegistrarService) {
+		t.Fatalf("err = %v, want ErrRegistrarService", err)
+	}
+}
+
+func TestRegistrar_MaintenanceWindowService(t *testing.T) {
+	c := newTestRegistrarMOSAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	start := time.Now().Add(24 * time.Hour)
+	if _, err := c.CreateMaintenanceWindow(context.Background(), NewMaintenanceWindow(base.ServiceDNS, start, start.Add(time.Hour))); !errors.Is(err, base.ErrRegistrarService) {
+		t.Fatalf("CreateMaintenanceWindow(DNS) = %v, want ErrRegistrarService", err)
+	}
+}
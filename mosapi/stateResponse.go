@@ -3,7 +3,8 @@ package mosapi
 import "time"
 
 type StateResponse struct {
-	TLD             string `json:"tld"`
+	TLD             string `json:"tld,omitempty"`
+	IANAID          int    `json:"ianaId,omitempty"`      // Set instead of TLD for registrars.
 	LastUpdateApiDb int64  `json:"lastUpdateApiDatabase"` // Unix timestamp seconds when monitoring info was last updated.
 	// Status: the current status of the Service. The possible values are:
 	// Up: all of the monitored Services are up.
@@ -40,13 +41,33 @@ func (s *TestedService) HasIncidents() bool {
 	return len(s.Incidents) > 0
 }
 
+// IncidentState is the state of an Incident as reported by MOSAPI.
+type IncidentState string
+
+const (
+	// IncidentActive indicates the Incident is ongoing.
+	IncidentActive IncidentState = "Active"
+	// IncidentResolved indicates the Incident has ended.
+	IncidentResolved IncidentState = "Resolved"
+)
+
+// IsValid reports whether s is one of the known incident states.
+func (s IncidentState) IsValid() bool {
+	return s == IncidentActive || s == IncidentResolved
+}
+
 // Incident is a struct that represents an incident in the MOSAPI
 type Incident struct {
-	IncidentID    string `json:"incidentID"`
-	EndTime       *int64 `json:"endTime"`
-	StartTime     int64  `json:"startTime"`
-	FalsePositive bool   `json:"falsePositive"`
-	State         string `json:"state"`
+	IncidentID    string        `json:"incidentID"`
+	EndTime       *int64        `json:"endTime"`
+	StartTime     int64         `json:"startTime"`
+	FalsePositive bool          `json:"falsePositive"`
+	State         IncidentState `json:"state"`
+}
+
+// IsActive reports whether the Incident is ongoing.
+func (i Incident) IsActive() bool {
+	return i.State == IncidentActive
 }
 
 func (s *StateResponse) AllServicesUp() bool {
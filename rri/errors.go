@@ -0,0 +1,14 @@
+package rri
+
+import "fmt"
+
+var (
+	ErrEmptyReport          = fmt.Errorf("report document is empty")
+	ErrMalformedReport      = fmt.Errorf("report document is not well-formed XML")
+	ErrNotificationRejected = fmt.Errorf("escrow notification rejected by ICANN")
+	ErrReportRejected       = fmt.Errorf("monthly report rejected by ICANN")
+	ErrEmptyCSV             = fmt.Errorf("report CSV is empty")
+	ErrInvalidReportType    = fmt.Errorf("invalid monthly report type (want transactions or activity)")
+	ErrInvalidDateRange     = fmt.Errorf("date range ends before it starts")
+	ErrDateRangeTooLong     = fmt.Errorf("date range exceeds 366 days")
+)
@@ -0,0 +1,165 @@
+package rri
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/onasunnymorning/icann-client/rri/rdereport"
+)
+
+// Result is a result code and message from ICANN's response document.
+type Result struct {
+	Code    int    `xml:"code,attr" json:"code"`
+	Message string `xml:"msg" json:"message"`
+}
+
+// reportResponse is the XML document returned for report uploads:
+//
+//	<reportResponse xmlns="urn:ietf:params:xml:ns:reportResponse-1.0">
+//	  <result code="1000"><msg>Command completed successfully</msg></result>
+//	</reportResponse>
+type reportResponse struct {
+	XMLName xml.Name `xml:"reportResponse"`
+	Results []Result `xml:"result"`
+}
+
+// NotificationResult is the outcome of an escrow deposit notification.
+type NotificationResult struct {
+	TLD        string    `json:"tld"`
+	Date       time.Time `json:"date"`
+	Accepted   bool      `json:"accepted"`
+	StatusCode int       `json:"statusCode"` // HTTP status of the upload
+	Results    []Result  `json:"results,omitempty"`
+}
+
+// Err returns nil for accepted notifications and an error wrapping
+// ErrNotificationRejected with ICANN's result codes otherwise.
+func (r *NotificationResult) Err() error {
+	if r.Accepted {
+		return nil
+	}
+	return rejection(ErrNotificationRejected, r.StatusCode, r.Results)
+}
+
+// rejection wraps sentinel with ICANN's result codes, or the HTTP status without them.
+func rejection(sentinel error, status int, results []Result) error {
+	msgs := make([]string, 0, len(results))
+	for _, res := range results {
+		msgs = append(msgs, fmt.Sprintf("%d %s", res.Code, res.Message))
+	}
+	if len(msgs) == 0 {
+		return fmt.Errorf("%w (HTTP %d)", sentinel, status)
+	}
+	return fmt.Errorf("%w: %s", sentinel, strings.Join(msgs, "; "))
+}
+
+// NotifyRyEscrowDeposit uploads the RDE report (deposit notification) XML document for
+// the client's TLD and the given date to the registry escrow report resource (see
+// escrowReportPath). Documents rejected by ICANN's validation are
+// returned as a NotificationResult with Accepted false and ICANN's result codes, not
+// as an error; see NotificationResult.Err. Other failures return a *base.HTTPError.
+func (c *Client) NotifyRyEscrowDeposit(ctx context.Context, date time.Time, report []byte) (*NotificationResult, error) {
+	if len(bytes.TrimSpace(report)) == 0 {
+		return nil, ErrEmptyReport
+	}
+	if err := checkWellFormed(report); err != nil {
+		return nil, err
+	}
+	cfg := c.Config()
+	ctx = base.WithOperation(ctx, "rri.NotifyRyEscrowDeposit")
+	ctx = base.WithEndpoint(ctx, escrowReportEndpoint)
+	up, err := c.putReport(ctx, escrowReportPath(cfg.TLD, date), "text/xml", report)
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationResult{TLD: cfg.TLD, Date: date, Accepted: up.Accepted, StatusCode: up.StatusCode, Results: up.Results}, nil
+}
+
+// uploadResult is ICANN's answer to a report upload.
+type uploadResult struct {
+	Accepted   bool
+	StatusCode int
+	Results    []Result
+}
+
+// putReport uploads a report document and reports whether ICANN accepted it. Validation
+// failures carrying a result document are not errors; other failures return a *base.HTTPError.
+func (c *Client) putReport(ctx context.Context, path, contentType string, doc []byte) (*uploadResult, error) {
+	req, err := c.NewRequest(ctx, http.MethodPut, path, bytes.NewReader(doc))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		// The response document is informational on success
+		results, _ := decodeResults(resp.Body)
+		return &uploadResult{Accepted: true, StatusCode: resp.StatusCode, Results: results}, nil
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		// Validation failures carry a result document; anything else is a plain HTTP error
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		if err != nil {
+			return nil, err
+		}
+		results, err := decodeResults(bytes.NewReader(body))
+		if err != nil || len(results) == 0 {
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			return nil, base.NewHTTPError(req, resp)
+		}
+		return &uploadResult{StatusCode: resp.StatusCode, Results: results}, nil
+	default:
+		return nil, base.NewHTTPError(req, resp)
+	}
+}
+
+// NotifyRyEscrowReport validates r offline and sends it with NotifyRyEscrowDeposit
+// for the date of its watermark.
+func (c *Client) NotifyRyEscrowReport(ctx context.Context, r *rdereport.Report) (*NotificationResult, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	doc, err := r.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return c.NotifyRyEscrowDeposit(ctx, r.Date(), doc)
+}
+
+// decodeResults parses the result codes of a report response document.
+func decodeResults(r io.Reader) ([]Result, error) {
+	var doc reportResponse
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	for i := range doc.Results {
+		doc.Results[i].Message = strings.TrimSpace(doc.Results[i].Message)
+	}
+	return doc.Results, nil
+}
+
+// checkWellFormed reports ErrMalformedReport unless doc is well-formed XML.
+func checkWellFormed(doc []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	for {
+		if _, err := d.Token(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("%w: %v", ErrMalformedReport, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package rri
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+	"github.com/onasunnymorning/icann-client/rri/rdereport"
+)
+
+const testReport = `<?xml version="1.0" encoding="UTF-8"?><rdeReport:report xmlns:rdeReport="urn:ietf:params:xml:ns:rdeReport-1.0"><rdeReport:id>20250102001</rdeReport:id></rdeReport:report>`
+
+func newTestRRI(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := New(base.Config{TLD: "example", AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p", RRIURL: srv.URL})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestNotifyRyEscrowDeposit_Accepted(t *testing.T) {
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/report/registry-escrow-report/example/2025-01-02" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "text/xml" || string(body) != testReport {
+			t.Errorf("Content-Type = %q, body = %q", r.Header.Get("Content-Type"), body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `<reportResponse xmlns="urn:ietf:params:xml:ns:reportResponse-1.0"><result code="1000"><msg>Command completed successfully</msg></result></reportResponse>`)
+	})
+	out, err := c.NotifyRyEscrowDeposit(context.Background(), time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), []byte(testReport))
+	if err != nil {
+		t.Fatalf("NotifyRyEscrowDeposit: %v", err)
+	}
+	if !out.Accepted || out.Err() != nil || out.StatusCode != http.StatusCreated {
+		t.Fatalf("result = %+v, want accepted", out)
+	}
+	if len(out.Results) != 1 || out.Results[0] != (Result{Code: 1000, Message: "Command completed successfully"}) {
+		t.Fatalf("results = %+v", out.Results)
+	}
+}
+
+func TestNotifyRyEscrowDeposit_ValidationFailure(t *testing.T) {
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<?xml version="1.0"?>
+<reportResponse xmlns="urn:ietf:params:xml:ns:reportResponse-1.0">
+  <result code="2003">
+    <msg>Failed schema validation</msg>
+  </result>
+</reportResponse>`)
+	})
+	out, err := c.NotifyRyEscrowDeposit(context.Background(), time.Now(), []byte(testReport))
+	if err != nil {
+		t.Fatalf("NotifyRyEscrowDeposit: %v", err)
+	}
+	if out.Accepted || len(out.Results) != 1 || out.Results[0].Code != 2003 || out.Results[0].Message != "Failed schema validation" {
+		t.Fatalf("result = %+v, want rejected with code 2003", out)
+	}
+	if err := out.Err(); !errors.Is(err, ErrNotificationRejected) || err.Error() != "escrow notification rejected by ICANN: 2003 Failed schema validation" {
+		t.Fatalf("Err() = %v", err)
+	}
+}
+
+func TestNotifyRyEscrowDeposit_Errors(t *testing.T) {
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/report/registry-escrow-report/example/2025-01-03" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	ctx := context.Background()
+	if _, err := c.NotifyRyEscrowDeposit(ctx, time.Now(), []byte("  ")); !errors.Is(err, ErrEmptyReport) {
+		t.Fatalf("empty report: %v, want ErrEmptyReport", err)
+	}
+	if _, err := c.NotifyRyEscrowDeposit(ctx, time.Now(), []byte("<report><id>1</report>")); !errors.Is(err, ErrMalformedReport) {
+		t.Fatalf("malformed report: %v, want ErrMalformedReport", err)
+	}
+	var he *base.HTTPError
+	if _, err := c.NotifyRyEscrowDeposit(ctx, time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), []byte(testReport)); !errors.As(err, &he) || he.StatusCode != http.StatusBadRequest || string(he.Body) != "bad request\n" {
+		t.Fatalf("400 without result document: %v, want HTTPError with body", err)
+	}
+	if _, err := c.NotifyRyEscrowDeposit(ctx, time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC), []byte(testReport)); !errors.Is(err, base.ErrUnauthorized) {
+		t.Fatalf("401: %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestNotifyRyEscrowReport_ValidatesAndUsesWatermarkDate(t *testing.T) {
+	var gotPath string
+	var got *rdereport.Report
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		var err error
+		if got, err = rdereport.Parse(body); err != nil {
+			t.Errorf("Parse uploaded report: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	r := rdereport.NewReport("20251022001", rdereport.KindFull, "example", time.Date(2025, 10, 22, 0, 0, 0, 0, time.UTC))
+	if _, err := c.NotifyRyEscrowReport(context.Background(), r); !errors.Is(err, rdereport.ErrCountsRequired) {
+		t.Fatalf("NotifyRyEscrowReport without counts = %v, want ErrCountsRequired", err)
+	}
+	if gotPath != "" {
+		t.Fatal("invalid report was uploaded")
+	}
+
+	r.SetCount(rdereport.URIDomain, 42)
+	out, err := c.NotifyRyEscrowReport(context.Background(), r)
+	if err != nil || !out.Accepted {
+		t.Fatalf("NotifyRyEscrowReport = %+v, %v", out, err)
+	}
+	if gotPath != "/report/registry-escrow-report/example/2025-10-22" || got == nil || got.ID != "20251022001" {
+		t.Fatalf("uploaded %s: %+v", gotPath, got)
+	}
+}
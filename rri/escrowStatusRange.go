@@ -0,0 +1,107 @@
+package rri
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultStatusConcurrency is the number of days checked in parallel by
+// GetRyEscrowReportStatusRange when no concurrency is given.
+const DefaultStatusConcurrency = 4
+
+// MaxStatusRangeDays bounds the number of days checked by GetRyEscrowReportStatusRange.
+const MaxStatusRangeDays = 366
+
+// EscrowDay is the Ry Escrow report status of one day of an EscrowStatusRange.
+type EscrowDay struct {
+	Date   time.Time `json:"date"`
+	Status string    `json:"status"` // one of RY_RDEReport_RECEIVED or RY_RDEReport_PENDING
+}
+
+// EscrowStatusRange is the Ry Escrow report status of every day in a date range.
+type EscrowStatusRange struct {
+	TLD     string      `json:"tld"`
+	From    time.Time   `json:"from"`
+	To      time.Time   `json:"to"`
+	Days    []EscrowDay `json:"days"`    // one per day, in date order
+	Missing []time.Time `json:"missing"` // days whose status is not RY_RDEReport_RECEIVED
+}
+
+// Received returns the number of days whose report was received.
+func (r *EscrowStatusRange) Received() int {
+	return len(r.Days) - len(r.Missing)
+}
+
+// GetRyEscrowReportStatusRange checks the Ry Escrow report status of every day from
+// from to to (inclusive, by calendar date), with at most concurrency requests in flight
+// (DefaultStatusConcurrency if not positive). The first failing day cancels the
+// remaining checks and its error is returned.
+func (c *Client) GetRyEscrowReportStatusRange(ctx context.Context, from, to time.Time, concurrency int) (*EscrowStatusRange, error) {
+	from, to = utcDate(from), utcDate(to)
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+	n := int(to.Sub(from)/(24*time.Hour)) + 1
+	if n > MaxStatusRangeDays {
+		return nil, ErrDateRangeTooLong
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultStatusConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	days := make([]EscrowDay, n)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := range days {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			date := from.AddDate(0, 0, i)
+			st, err := c.GetRyEscrowReportStatus(ctx, date)
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("%s: %w", date.Format("2006-01-02"), err)
+					cancel()
+				})
+				return
+			}
+			days[i] = EscrowDay{Date: st.Date, Status: st.Status}
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	out := &EscrowStatusRange{TLD: c.Config().TLD, From: from, To: to, Days: days, Missing: []time.Time{}}
+	for _, d := range days {
+		if d.Status != RY_RDEReport_RECEIVED {
+			out.Missing = append(out.Missing, d.Date)
+		}
+	}
+	return out, nil
+}
+
+// utcDate returns t's calendar date at midnight UTC.
+func utcDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
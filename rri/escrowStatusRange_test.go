@@ -0,0 +1,87 @@
+package rri
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+func TestGetRyEscrowReportStatusRange(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if strings.Contains(r.URL.Path, "2026-09-05") || strings.Contains(r.URL.Path, "2026-09-17") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	from := time.Date(2026, 9, 1, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	out, err := c.GetRyEscrowReportStatusRange(context.Background(), from, to, 3)
+	if err != nil {
+		t.Fatalf("GetRyEscrowReportStatusRange: %v", err)
+	}
+	if len(out.Days) != 30 || out.Received() != 28 || !out.From.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("days = %d, received = %d, from = %v", len(out.Days), out.Received(), out.From)
+	}
+	for i, d := range out.Days {
+		if want := out.From.AddDate(0, 0, i); !d.Date.Equal(want) {
+			t.Fatalf("day %d = %v, want %v", i, d.Date, want)
+		}
+	}
+	if b, _ := json.Marshal(out.Days[0]); string(b) != `{"date":"2026-09-01T00:00:00Z","status":"received"}` {
+		t.Fatalf("day JSON = %s", b)
+	}
+	if len(out.Missing) != 2 || out.Missing[0].Day() != 5 || out.Missing[1].Day() != 17 {
+		t.Fatalf("missing = %v, want Sep 5 and 17", out.Missing)
+	}
+	if m := maxInFlight.Load(); m > 3 || m < 2 {
+		t.Fatalf("max concurrent requests = %d, want 2-3", m)
+	}
+}
+
+func TestGetRyEscrowReportStatusRange_Errors(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if strings.Contains(r.URL.Path, "2026-09-02") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	ctx := context.Background()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := c.GetRyEscrowReportStatusRange(ctx, from, from.AddDate(0, 0, 29), 1)
+	if !errors.Is(err, base.ErrForbidden) || !strings.HasPrefix(err.Error(), "2026-09-02: ") {
+		t.Fatalf("err = %v, want ErrForbidden for 2026-09-02", err)
+	}
+	if n := calls.Load(); n > 3 {
+		t.Fatalf("%d requests after the failure, want the range to stop", n)
+	}
+	if _, err := c.GetRyEscrowReportStatusRange(ctx, from, from.AddDate(0, 0, -1), 0); !errors.Is(err, ErrInvalidDateRange) {
+		t.Fatalf("reversed range: %v, want ErrInvalidDateRange", err)
+	}
+	if _, err := c.GetRyEscrowReportStatusRange(ctx, from, from.AddDate(1, 1, 0), 0); !errors.Is(err, ErrDateRangeTooLong) {
+		t.Fatalf("long range: %v, want ErrDateRangeTooLong", err)
+	}
+}
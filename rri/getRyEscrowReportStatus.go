@@ -22,12 +22,26 @@ const (
 	RY_RDEReport_PENDING  = "pending"
 )
 
+// escrowReportEndpoint is the endpoint template of the registry escrow report resource.
+const escrowReportEndpoint = "report/registry-escrow-report/{tld}/{date}"
+
+// escrowReportPath returns the registry escrow report resource of tld and date,
+// /report/registry-escrow-report/<tld>/<yyyy-mm-dd>, as defined in the Registry
+// Reporting section of the ICANN Registry Interfaces specification
+// (draft-lozano-icann-registry-interfaces). The report is PUT to it, and a GET
+// answers 200 once a report for the date was received and 404 before.
+func escrowReportPath(tld string, date time.Time) string {
+	return fmt.Sprintf("/report/registry-escrow-report/%s/%s", tld, date.Format("2006-01-02"))
+}
+
 // Client provides RRI-specific helpers built on top of the shared client.
 type Client struct{ *base.Client }
 
-// New creates an RRI client using the shared configuration and auth.
-func New(cfg base.Config) (*Client, error) {
-	c, err := base.NewClient(cfg)
+// New creates an RRI client using the shared configuration and auth. It targets
+// cfg.RRIURL if set, otherwise the RRI endpoint for cfg.Environment.
+// Options such as base.WithMiddleware customize the shared client.
+func New(cfg base.Config, opts ...base.Option) (*Client, error) {
+	c, err := base.NewAPIClient(base.API_RRI, cfg, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,9 +52,9 @@ func New(cfg base.Config) (*Client, error) {
 // Per draft: HEAD will return 200 if available, 404 if not available.
 func (c *Client) GetRyEscrowReportStatus(ctx context.Context, date time.Time) (*ReportStatus, error) {
 	cfg := c.Config()
-	// Construct a reasonable path; adjust to spec as needed when finalized.
-	// Using an RRI-scoped path independent of MOSAPI entity/version routing.
-	path := fmt.Sprintf("/rri/escrow/ry/%s/%s/status", cfg.TLD, date.Format("2006-01-02"))
+	ctx = base.WithOperation(ctx, "rri.GetRyEscrowReportStatus")
+	ctx = base.WithEndpoint(ctx, escrowReportEndpoint)
+	path := escrowReportPath(cfg.TLD, date)
 	// Use GET instead of HEAD to avoid noisy http2 client logs when servers
 	// incorrectly send DATA on a HEAD response (observed in the wild).
 	// We only inspect the status code and ignore the body.
@@ -63,6 +77,6 @@ func (c *Client) GetRyEscrowReportStatus(ctx context.Context, date time.Time) (*
 		rs.Status = RY_RDEReport_PENDING
 		return rs, nil
 	default:
-		return nil, &base.HTTPError{StatusCode: resp.StatusCode, Method: req.Method, URL: req.URL.String()}
+		return nil, base.NewHTTPError(req, resp)
 	}
 }
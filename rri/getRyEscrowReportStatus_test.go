@@ -3,12 +3,16 @@ package rri
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	base "github.com/onasunnymorning/icann-client/client"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 type mockRoundTripper struct {
@@ -113,3 +117,67 @@ func TestCheckRyEscrowReport(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRyEscrowReport_ServerErrorSentinel(t *testing.T) {
+	rriClient, err := New(base.Config{TLD: "com", AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	_ = rriClient.WithBaseURL("https://example.com")
+	rriClient.HTTPClient = &http.Client{Transport: &mockRoundTripper{response: &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Body:       io.NopCloser(strings.NewReader(`{"resultCode": 5000, "message": "maintenance"}`)),
+		Request:    &http.Request{},
+	}}}
+
+	_, err = rriClient.GetRyEscrowReportStatus(context.Background(), time.Now())
+	if !errors.Is(err, base.ErrServerError) {
+		t.Fatalf("err = %v, want ErrServerError", err)
+	}
+	var he *base.HTTPError
+	if !errors.As(err, &he) || he.Message != "maintenance" {
+		t.Fatalf("err = %v, want parsed message", err)
+	}
+}
+
+func TestNew_TargetsRRIEndpoint(t *testing.T) {
+	var gotHost string
+	rriClient, err := New(base.Config{TLD: "com", AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p", Environment: base.ENV_OTE})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	rriClient.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		gotHost = r.URL.Scheme + "://" + r.URL.Host
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})}
+	if _, err := rriClient.GetRyEscrowReportStatus(context.Background(), time.Now()); err != nil {
+		t.Fatalf("GetRyEscrowReportStatus: %v", err)
+	}
+	if gotHost != base.RRI_OTE_URL {
+		t.Fatalf("host = %q, want %q", gotHost, base.RRI_OTE_URL)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestGetRyEscrowReportStatus_Span(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNotFound) }))
+	defer srv.Close()
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+
+	cfg := base.Config{TLD: "example", AuthType: base.AUTH_TYPE_BASIC, Username: "u", Password: "p", RRIURL: srv.URL}
+	c, err := New(cfg, base.WithTracerProvider(tp))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := c.GetRyEscrowReportStatus(context.Background(), time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("GetRyEscrowReportStatus: %v", err)
+	}
+	spans := exp.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "rri.GetRyEscrowReportStatus" {
+		t.Fatalf("spans = %+v, want one rri.GetRyEscrowReportStatus span", spans)
+	}
+}
@@ -0,0 +1,93 @@
+package rri
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	base "github.com/onasunnymorning/icann-client/client"
+)
+
+// MonthlyReportType identifies a monthly registry report.
+type MonthlyReportType string
+
+const (
+	// ReportTransactions is the per-registrar transactions report.
+	ReportTransactions MonthlyReportType = "transactions"
+	// ReportActivity is the registry functions activity report.
+	ReportActivity MonthlyReportType = "activity"
+)
+
+// Validate checks t against the known report types.
+func (t MonthlyReportType) Validate() error {
+	switch t {
+	case ReportTransactions, ReportActivity:
+		return nil
+	}
+	return ErrInvalidReportType
+}
+
+// resource returns the RRI report resource name of t.
+func (t MonthlyReportType) resource() string {
+	if t == ReportActivity {
+		return "registry-functions-activity"
+	}
+	return "registrar-transactions"
+}
+
+// MonthlyReportUpload is the outcome of a monthly report upload.
+type MonthlyReportUpload struct {
+	Type       MonthlyReportType `json:"type"`
+	TLD        string            `json:"tld"`
+	Month      string            `json:"month"` // YYYY-MM
+	Accepted   bool              `json:"accepted"`
+	StatusCode int               `json:"statusCode"` // HTTP status of the upload
+	Results    []Result          `json:"results,omitempty"`
+}
+
+// Err returns nil for accepted uploads and an error wrapping ErrReportRejected
+// with ICANN's result codes otherwise.
+func (u *MonthlyReportUpload) Err() error {
+	if u.Accepted {
+		return nil
+	}
+	return rejection(ErrReportRejected, u.StatusCode, u.Results)
+}
+
+// monthlyReportPath returns the report resource of t for the client's TLD and month,
+// /report/registrar-transactions/<tld>/<yyyy-mm> or
+// /report/registry-functions-activity/<tld>/<yyyy-mm>, as defined in the Registry
+// Reporting section of the ICANN Registry Interfaces specification
+// (draft-lozano-icann-registry-interfaces).
+func (c *Client) monthlyReportPath(t MonthlyReportType, month time.Time) string {
+	return fmt.Sprintf("/report/%s/%s/%s", t.resource(), c.Config().TLD, month.Format("2006-01"))
+}
+
+// monthlyReportEndpoint returns the endpoint template of t's report resource.
+func monthlyReportEndpoint(t MonthlyReportType) string {
+	return "report/" + t.resource() + "/{tld}/{month}"
+}
+
+// UploadMonthlyReport uploads (PUT) the CSV report of type t for the client's TLD and
+// the month of the given date to the report resource (see monthlyReportPath). Reports
+// rejected by ICANN's validation are returned with Accepted false and ICANN's result
+// codes, not as an error; see MonthlyReportUpload.Err.
+func (c *Client) UploadMonthlyReport(ctx context.Context, t MonthlyReportType, month time.Time, csv []byte) (*MonthlyReportUpload, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(csv)) == 0 {
+		return nil, ErrEmptyCSV
+	}
+	ctx = base.WithOperation(ctx, "rri.UploadMonthlyReport")
+	ctx = base.WithEndpoint(ctx, monthlyReportEndpoint(t))
+	up, err := c.putReport(ctx, c.monthlyReportPath(t, month), "text/csv", csv)
+	if err != nil {
+		return nil, err
+	}
+	return &MonthlyReportUpload{
+		Type: t, TLD: c.Config().TLD, Month: month.Format("2006-01"),
+		Accepted: up.Accepted, StatusCode: up.StatusCode, Results: up.Results,
+	}, nil
+}
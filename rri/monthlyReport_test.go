@@ -0,0 +1,50 @@
+package rri
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testMonth = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+func TestUploadMonthlyReport(t *testing.T) {
+	const csv = "operator-name,ry-name,tld,...\n"
+	c := newTestRRI(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.Header.Get("Content-Type") != "text/csv" || string(body) != csv {
+			t.Errorf("request = %s %s %q", r.Method, r.Header.Get("Content-Type"), body)
+		}
+		switch r.URL.Path {
+		case "/report/registrar-transactions/example/2026-09":
+			w.WriteHeader(http.StatusCreated)
+		case "/report/registry-functions-activity/example/2026-09":
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `<reportResponse xmlns="urn:ietf:params:xml:ns:reportResponse-1.0"><result code="2003"><msg>Line 2: invalid IANA ID</msg></result></reportResponse>`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+	out, err := c.UploadMonthlyReport(ctx, ReportTransactions, testMonth, []byte(csv))
+	if err != nil || !out.Accepted || out.Err() != nil || out.Month != "2026-09" || out.TLD != "example" {
+		t.Fatalf("transactions upload = %+v, %v", out, err)
+	}
+	out, err = c.UploadMonthlyReport(ctx, ReportActivity, testMonth, []byte(csv))
+	if err != nil {
+		t.Fatalf("activity upload: %v", err)
+	}
+	if err := out.Err(); !errors.Is(err, ErrReportRejected) || out.Results[0].Code != 2003 {
+		t.Fatalf("activity upload = %+v, Err() = %v", out, err)
+	}
+
+	if _, err := c.UploadMonthlyReport(ctx, "registrars", testMonth, []byte(csv)); !errors.Is(err, ErrInvalidReportType) {
+		t.Fatalf("unknown type: %v, want ErrInvalidReportType", err)
+	}
+	if _, err := c.UploadMonthlyReport(ctx, ReportActivity, testMonth, nil); !errors.Is(err, ErrEmptyCSV) {
+		t.Fatalf("empty CSV: %v, want ErrEmptyCSV", err)
+	}
+}
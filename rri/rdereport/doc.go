@@ -0,0 +1,7 @@
+// Package rdereport builds, parses and validates RDE report documents
+// (urn:ietf:params:xml:ns:rdeReport-1.0, RFC 9022), the escrow deposit
+// notification a registry operator sends to ICANN via RRI.
+//
+// Build a Report from the escrow pipeline's deposit metadata, check it with
+// Validate and send it with rri.Client.NotifyRyEscrowReport.
+package rdereport
@@ -0,0 +1,19 @@
+package rdereport
+
+import "fmt"
+
+var (
+	ErrIDRequired         = fmt.Errorf("report id is required")
+	ErrInvalidID          = fmt.Errorf("report id must not contain whitespace")
+	ErrInvalidVersion     = fmt.Errorf("report version must be at least 1")
+	ErrInvalidResend      = fmt.Errorf("report resend must not be negative")
+	ErrCrDateRequired     = fmt.Errorf("report crDate is required")
+	ErrWatermarkRequired  = fmt.Errorf("report watermark is required")
+	ErrWatermarkAfterCr   = fmt.Errorf("report watermark must not be after crDate")
+	ErrInvalidKind        = fmt.Errorf("report kind must be FULL, INCR or DIFF")
+	ErrTLDRequired        = fmt.Errorf("report header tld is required")
+	ErrCountsRequired     = fmt.Errorf("report header must count the escrowed domains")
+	ErrCountURIRequired   = fmt.Errorf("report header count uri is required")
+	ErrDuplicateCountURI  = fmt.Errorf("report header counts an object uri more than once")
+	ErrNotAReportDocument = fmt.Errorf("document is not an RDE report")
+)
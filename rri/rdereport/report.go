@@ -0,0 +1,276 @@
+package rdereport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// XML namespaces of the report and its header.
+const (
+	NamespaceReport = "urn:ietf:params:xml:ns:rdeReport-1.0"
+	NamespaceHeader = "urn:ietf:params:xml:ns:rdeHeader-1.0"
+)
+
+// Object URIs counted in the report header (RFC 9022).
+const (
+	URIDomain    = "urn:ietf:params:xml:ns:rdeDomain-1.0"
+	URIHost      = "urn:ietf:params:xml:ns:rdeHost-1.0"
+	URIContact   = "urn:ietf:params:xml:ns:rdeContact-1.0"
+	URIRegistrar = "urn:ietf:params:xml:ns:rdeRegistrar-1.0"
+	URIIDN       = "urn:ietf:params:xml:ns:rdeIDN-1.0"
+	URINNDN      = "urn:ietf:params:xml:ns:rdeNNDN-1.0"
+	URIEPPParams = "urn:ietf:params:xml:ns:rdeEppParams-1.0"
+)
+
+// Default specifications referenced by new reports.
+const (
+	DefaultRydeSpecEscrow  = "RFC8909"
+	DefaultRydeSpecMapping = "RFC9022"
+)
+
+// Kind is the type of the escrow deposit a report describes.
+type Kind string
+
+const (
+	KindFull         Kind = "FULL"
+	KindIncremental  Kind = "INCR"
+	KindDifferential Kind = "DIFF"
+)
+
+// Report is an RDE report: the metadata of one escrow deposit.
+type Report struct {
+	ID              string    // deposit ID, e.g. "20251022001"
+	Version         int       // report version, 1 unless ICANN specifies otherwise
+	RydeSpecEscrow  string    // escrow format specification, e.g. "RFC8909"
+	RydeSpecMapping string    // object mapping specification, e.g. "RFC9022"
+	Resend          int       // number of times the deposit was resent, 0 for the first deposit
+	CrDate          time.Time // creation time of the report
+	Kind            Kind      // KindFull, KindIncremental or KindDifferential
+	Watermark       time.Time // point in time the deposit reflects
+	Header          Header
+}
+
+// Header carries the TLD and the number of escrowed objects per object URI.
+type Header struct {
+	TLD    string
+	Counts []Count
+}
+
+// Count is the number of escrowed objects of one URI, optionally per
+// registry class domain name (RCDN).
+type Count struct {
+	URI   string
+	RCDN  string
+	Value uint64
+}
+
+// NewReport builds a version 1 Report of the deposit with the given ID, kind and
+// watermark for tld, created now. Add object counts with SetCount.
+func NewReport(id string, kind Kind, tld string, watermark time.Time) *Report {
+	return &Report{
+		ID:              id,
+		Version:         1,
+		RydeSpecEscrow:  DefaultRydeSpecEscrow,
+		RydeSpecMapping: DefaultRydeSpecMapping,
+		CrDate:          time.Now().UTC(),
+		Kind:            kind,
+		Watermark:       watermark.UTC(),
+		Header:          Header{TLD: tld},
+	}
+}
+
+// SetCount sets the number of escrowed objects of uri, replacing an existing count.
+func (r *Report) SetCount(uri string, n uint64) {
+	for i, c := range r.Header.Counts {
+		if c.URI == uri && c.RCDN == "" {
+			r.Header.Counts[i].Value = n
+			return
+		}
+	}
+	r.Header.Counts = append(r.Header.Counts, Count{URI: uri, Value: n})
+}
+
+// Count returns the number of escrowed objects of uri, summed over RCDNs.
+func (h Header) Count(uri string) (uint64, bool) {
+	var n uint64
+	found := false
+	for _, c := range h.Counts {
+		if c.URI == uri {
+			n += c.Value
+			found = true
+		}
+	}
+	return n, found
+}
+
+// Date returns the UTC date of the watermark, the date the deposit is due for.
+func (r Report) Date() time.Time {
+	return r.Watermark.UTC().Truncate(24 * time.Hour)
+}
+
+// Validate checks the fields ICANN requires: ID, version, resend, crDate,
+// kind, a watermark not after crDate, the TLD and unique object counts
+// including the domain count.
+func (r Report) Validate() error {
+	switch {
+	case r.ID == "":
+		return ErrIDRequired
+	case strings.ContainsAny(r.ID, " \t\r\n"):
+		return ErrInvalidID
+	case r.Version < 1:
+		return ErrInvalidVersion
+	case r.Resend < 0:
+		return ErrInvalidResend
+	case r.CrDate.IsZero():
+		return ErrCrDateRequired
+	case r.Watermark.IsZero():
+		return ErrWatermarkRequired
+	case r.Watermark.After(r.CrDate):
+		return ErrWatermarkAfterCr
+	}
+	switch r.Kind {
+	case KindFull, KindIncremental, KindDifferential:
+	default:
+		return ErrInvalidKind
+	}
+	if r.Header.TLD == "" {
+		return ErrTLDRequired
+	}
+	type key struct{ uri, rcdn string }
+	seen := make(map[key]bool, len(r.Header.Counts))
+	for _, c := range r.Header.Counts {
+		if c.URI == "" {
+			return ErrCountURIRequired
+		}
+		k := key{c.URI, c.RCDN}
+		if seen[k] {
+			return fmt.Errorf("%w: %s", ErrDuplicateCountURI, c.URI)
+		}
+		seen[k] = true
+	}
+	if _, ok := r.Header.Count(URIDomain); !ok {
+		return ErrCountsRequired
+	}
+	return nil
+}
+
+// Marshal returns the report as an XML document with the rdeReport and rdeHeader prefixes.
+func (r Report) Marshal() ([]byte, error) {
+	w := reportOut{
+		NSReport:        NamespaceReport,
+		NSHeader:        NamespaceHeader,
+		ID:              r.ID,
+		Version:         r.Version,
+		RydeSpecEscrow:  r.RydeSpecEscrow,
+		RydeSpecMapping: r.RydeSpecMapping,
+		Resend:          r.Resend,
+		CrDate:          r.CrDate.UTC().Format(time.RFC3339Nano),
+		Kind:            string(r.Kind),
+		Watermark:       r.Watermark.UTC().Format(time.RFC3339Nano),
+	}
+	w.Header.TLD = r.Header.TLD
+	for _, c := range r.Header.Counts {
+		w.Header.Counts = append(w.Header.Counts, countOut(c))
+	}
+	out, err := xml.MarshalIndent(w, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+// Parse decodes an RDE report document. It does not validate the report.
+func Parse(doc []byte) (*Report, error) {
+	var w reportIn
+	if err := xml.NewDecoder(bytes.NewReader(doc)).Decode(&w); err != nil {
+		if _, ok := err.(xml.UnmarshalError); ok {
+			return nil, fmt.Errorf("%w: %v", ErrNotAReportDocument, err)
+		}
+		return nil, err
+	}
+	r := &Report{
+		ID:              strings.TrimSpace(w.ID),
+		Version:         w.Version,
+		RydeSpecEscrow:  strings.TrimSpace(w.RydeSpecEscrow),
+		RydeSpecMapping: strings.TrimSpace(w.RydeSpecMapping),
+		Resend:          w.Resend,
+		Kind:            Kind(strings.TrimSpace(w.Kind)),
+		Header:          Header{TLD: strings.TrimSpace(w.Header.TLD)},
+	}
+	var err error
+	if r.CrDate, err = parseTime("crDate", w.CrDate); err != nil {
+		return nil, err
+	}
+	if r.Watermark, err = parseTime("watermark", w.Watermark); err != nil {
+		return nil, err
+	}
+	for _, c := range w.Header.Counts {
+		r.Header.Counts = append(r.Header.Counts, Count(c))
+	}
+	return r, nil
+}
+
+// parseTime parses an optional xsd:dateTime field.
+func parseTime(field, v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	return t.UTC(), nil
+}
+
+// reportOut is the wire form used by Marshal, with literal namespace prefixes.
+type reportOut struct {
+	XMLName         xml.Name `xml:"rdeReport:report"`
+	NSReport        string   `xml:"xmlns:rdeReport,attr"`
+	NSHeader        string   `xml:"xmlns:rdeHeader,attr"`
+	ID              string   `xml:"rdeReport:id"`
+	Version         int      `xml:"rdeReport:version"`
+	RydeSpecEscrow  string   `xml:"rdeReport:rydeSpecEscrow,omitempty"`
+	RydeSpecMapping string   `xml:"rdeReport:rydeSpecMapping,omitempty"`
+	Resend          int      `xml:"rdeReport:resend"`
+	CrDate          string   `xml:"rdeReport:crDate"`
+	Kind            string   `xml:"rdeReport:kind"`
+	Watermark       string   `xml:"rdeReport:watermark"`
+	Header          struct {
+		TLD    string     `xml:"rdeHeader:tld"`
+		Counts []countOut `xml:"rdeHeader:count"`
+	} `xml:"rdeHeader:header"`
+}
+
+type countOut struct {
+	URI   string `xml:"uri,attr"`
+	RCDN  string `xml:"rcdn,attr,omitempty"`
+	Value uint64 `xml:",chardata"`
+}
+
+// reportIn is the wire form used by Parse, matching elements by namespace
+// regardless of the prefixes used in the document.
+type reportIn struct {
+	XMLName         xml.Name `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 report"`
+	ID              string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 id"`
+	Version         int      `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 version"`
+	RydeSpecEscrow  string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 rydeSpecEscrow"`
+	RydeSpecMapping string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 rydeSpecMapping"`
+	Resend          int      `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 resend"`
+	CrDate          string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 crDate"`
+	Kind            string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 kind"`
+	Watermark       string   `xml:"urn:ietf:params:xml:ns:rdeReport-1.0 watermark"`
+	Header          struct {
+		TLD    string    `xml:"urn:ietf:params:xml:ns:rdeHeader-1.0 tld"`
+		Counts []countIn `xml:"urn:ietf:params:xml:ns:rdeHeader-1.0 count"`
+	} `xml:"urn:ietf:params:xml:ns:rdeHeader-1.0 header"`
+}
+
+type countIn struct {
+	URI   string `xml:"uri,attr"`
+	RCDN  string `xml:"rcdn,attr"`
+	Value uint64 `xml:",chardata"`
+}
@@ -0,0 +1,131 @@
+package rdereport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc9022Example follows the RDE report example of RFC 9022.
+const rfc9022Example = `<?xml version="1.0" encoding="UTF-8"?>
+<rdeReport:report
+  xmlns:rdeReport="urn:ietf:params:xml:ns:rdeReport-1.0"
+  xmlns:rdeHeader="urn:ietf:params:xml:ns:rdeHeader-1.0">
+  <rdeReport:id>20191017001</rdeReport:id>
+  <rdeReport:version>1</rdeReport:version>
+  <rdeReport:rydeSpecEscrow>RFC8909</rdeReport:rydeSpecEscrow>
+  <rdeReport:rydeSpecMapping>RFC9022</rdeReport:rydeSpecMapping>
+  <rdeReport:resend>0</rdeReport:resend>
+  <rdeReport:crDate>2019-10-17T00:15:00.0Z</rdeReport:crDate>
+  <rdeReport:kind>FULL</rdeReport:kind>
+  <rdeReport:watermark>2019-10-17T00:00:00Z</rdeReport:watermark>
+  <rdeHeader:header>
+    <rdeHeader:tld>test</rdeHeader:tld>
+    <rdeHeader:count uri="urn:ietf:params:xml:ns:rdeDomain-1.0">2</rdeHeader:count>
+    <rdeHeader:count uri="urn:ietf:params:xml:ns:rdeHost-1.0">
+      1
+    </rdeHeader:count>
+    <rdeHeader:count uri="urn:ietf:params:xml:ns:rdeContact-1.0">1</rdeHeader:count>
+    <rdeHeader:count uri="urn:ietf:params:xml:ns:rdeRegistrar-1.0">1</rdeHeader:count>
+  </rdeHeader:header>
+</rdeReport:report>`
+
+func TestParse_RFC9022Example(t *testing.T) {
+	r, err := Parse([]byte(rfc9022Example))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if err := r.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if r.ID != "20191017001" || r.Version != 1 || r.Kind != KindFull || r.Header.TLD != "test" || r.Resend != 0 {
+		t.Fatalf("report = %+v", r)
+	}
+	if !r.CrDate.Equal(time.Date(2019, 10, 17, 0, 15, 0, 0, time.UTC)) || !r.Date().Equal(time.Date(2019, 10, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("crDate = %v, date = %v", r.CrDate, r.Date())
+	}
+	if n, ok := r.Header.Count(URIHost); !ok || n != 1 {
+		t.Fatalf("host count = %d, %v", n, ok)
+	}
+	if n, _ := r.Header.Count(URIDomain); n != 2 {
+		t.Fatalf("domain count = %d, want 2", n)
+	}
+}
+
+func TestReport_MarshalRoundTrip(t *testing.T) {
+	r := NewReport("20251022001", KindIncremental, "example", time.Date(2025, 10, 22, 0, 0, 0, 0, time.UTC))
+	r.CrDate = time.Date(2025, 10, 22, 0, 30, 0, 0, time.UTC)
+	r.SetCount(URIDomain, 1000)
+	r.SetCount(URIHost, 20)
+	r.SetCount(URIDomain, 1001)
+
+	doc, err := r.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	for _, want := range []string{
+		`<rdeReport:report xmlns:rdeReport="urn:ietf:params:xml:ns:rdeReport-1.0" xmlns:rdeHeader="urn:ietf:params:xml:ns:rdeHeader-1.0">`,
+		`<rdeReport:kind>INCR</rdeReport:kind>`,
+		`<rdeReport:watermark>2025-10-22T00:00:00Z</rdeReport:watermark>`,
+		`<rdeHeader:count uri="urn:ietf:params:xml:ns:rdeDomain-1.0">1001</rdeHeader:count>`,
+	} {
+		if !strings.Contains(string(doc), want) {
+			t.Fatalf("document lacks %s:\n%s", want, doc)
+		}
+	}
+	got, err := Parse(doc)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got.ID != r.ID || got.Kind != r.Kind || !got.CrDate.Equal(r.CrDate) || len(got.Header.Counts) != 2 || got.RydeSpecMapping != DefaultRydeSpecMapping {
+		t.Fatalf("round trip = %+v, want %+v", got, r)
+	}
+}
+
+func TestReport_Validate(t *testing.T) {
+	wm := time.Date(2025, 10, 22, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		mutate  func(r *Report)
+		wantErr error
+	}{
+		{"valid", func(r *Report) {}, nil},
+		{"missing id", func(r *Report) { r.ID = "" }, ErrIDRequired},
+		{"id with space", func(r *Report) { r.ID = "2025 1" }, ErrInvalidID},
+		{"version 0", func(r *Report) { r.Version = 0 }, ErrInvalidVersion},
+		{"negative resend", func(r *Report) { r.Resend = -1 }, ErrInvalidResend},
+		{"missing crDate", func(r *Report) { r.CrDate = time.Time{} }, ErrCrDateRequired},
+		{"missing watermark", func(r *Report) { r.Watermark = time.Time{} }, ErrWatermarkRequired},
+		{"watermark after crDate", func(r *Report) { r.CrDate = wm.Add(-time.Hour) }, ErrWatermarkAfterCr},
+		{"unknown kind", func(r *Report) { r.Kind = "PARTIAL" }, ErrInvalidKind},
+		{"missing tld", func(r *Report) { r.Header.TLD = "" }, ErrTLDRequired},
+		{"missing domain count", func(r *Report) { r.Header.Counts = []Count{{URI: URIHost, Value: 1}} }, ErrCountsRequired},
+		{"empty count uri", func(r *Report) { r.Header.Counts = append(r.Header.Counts, Count{Value: 1}) }, ErrCountURIRequired},
+		{"duplicate count", func(r *Report) { r.Header.Counts = append(r.Header.Counts, Count{URI: URIDomain, Value: 1}) }, ErrDuplicateCountURI},
+		{"counts per rcdn", func(r *Report) {
+			r.Header.Counts = []Count{{URI: URIDomain, RCDN: "a.example", Value: 1}, {URI: URIDomain, RCDN: "b.example", Value: 2}}
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReport("20251022001", KindFull, "example", wm)
+			r.CrDate = wm.Add(time.Hour)
+			r.SetCount(URIDomain, 10)
+			tt.mutate(r)
+			if err := r.Validate(); !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	if _, err := Parse([]byte(`<reportResponse xmlns="urn:ietf:params:xml:ns:reportResponse-1.0"/>`)); !errors.Is(err, ErrNotAReportDocument) {
+		t.Fatalf("Parse(other document) = %v, want ErrNotAReportDocument", err)
+	}
+	doc := strings.Replace(rfc9022Example, "2019-10-17T00:15:00.0Z", "yesterday", 1)
+	if _, err := Parse([]byte(doc)); err == nil || !strings.Contains(err.Error(), "invalid crDate") {
+		t.Fatalf("Parse(bad crDate) = %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+// Package reportcsv validates the monthly registry report CSV files offline,
+// before they are uploaded with rri.Client.UploadMonthlyReport: the
+// per-registrar transactions report and the registry functions activity
+// report of the Registry Agreement (Specification 3).
+//
+// Validate checks the header names and order, numeric fields, IANA IDs and,
+// for the transactions report, the totals row, and reports every problem with
+// its line and column.
+package reportcsv
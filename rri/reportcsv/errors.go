@@ -0,0 +1,7 @@
+package reportcsv
+
+import "errors"
+
+// ErrUnknownReportType is returned by SchemaFor for names other than "transactions"
+// and "activity".
+var ErrUnknownReportType = errors.New("unknown report type (want transactions or activity)")
@@ -0,0 +1,145 @@
+package reportcsv
+
+import "fmt"
+
+// Kind is the value type of a report column.
+type Kind int
+
+const (
+	// Text is a non-empty string, e.g. a registrar name.
+	Text Kind = iota
+	// IANAID is a registrar IANA ID: a positive integer, unique within the report.
+	IANAID
+	// Count is a non-negative integer.
+	Count
+	// CountOrCZDS is a Count, or "CZDS" when zone file access is provided through ICANN's CZDS.
+	CountOrCZDS
+)
+
+// Column is a report column.
+type Column struct {
+	Name string
+	Kind Kind
+}
+
+// Schema describes the columns and layout of a report CSV.
+type Schema struct {
+	// Name is the report type, "transactions" or "activity" as in rri.MonthlyReportType.
+	Name    string
+	Columns []Column
+	// Rows is the exact number of data rows after the header, or 0 for any number.
+	Rows int
+	// TotalsRow requires a last row whose first field is "Totals", whose second
+	// field is empty and whose Count columns hold the sums over all other rows.
+	TotalsRow bool
+}
+
+// TotalsLabel is the first field of the transactions report's totals row.
+const TotalsLabel = "Totals"
+
+// Transactions is the per-registrar transactions report: one row per registrar
+// followed by the totals row.
+var Transactions = Schema{
+	Name: "transactions",
+	Columns: append([]Column{
+		{"registrar-name", Text},
+		{"iana-id", IANAID},
+		{"total-domains", Count},
+		{"total-nameservers", Count},
+	}, append(append(
+		yearColumns("net-adds"),
+		yearColumns("net-renews")...),
+		counts(
+			"transfer-gaining-successful",
+			"transfer-gaining-nacked",
+			"transfer-losing-successful",
+			"transfer-losing-nacked",
+			"transfer-disputed-won",
+			"transfer-disputed-lost",
+			"transfer-disputed-nodecision",
+			"deleted-domains-grace",
+			"deleted-domains-nograce",
+			"restored-domains",
+			"restored-noreport",
+			"agp-exemption-requests",
+			"agp-exemptions-granted",
+			"agp-exempted-domains",
+			"attempted-adds",
+		)...)...),
+	TotalsRow: true,
+}
+
+// Activity is the registry functions activity report: a single row of values.
+var Activity = Schema{
+	Name: "activity",
+	Columns: append([]Column{
+		{"operational-registrars", Count},
+		{"zfa-passwords", CountOrCZDS},
+	}, counts(
+		"whois-43-queries",
+		"web-whois-queries",
+		"searchable-whois-queries",
+		"dns-udp-queries-received",
+		"dns-udp-queries-responded",
+		"dns-tcp-queries-received",
+		"dns-tcp-queries-responded",
+		"srs-dom-check",
+		"srs-dom-create",
+		"srs-dom-delete",
+		"srs-dom-info",
+		"srs-dom-renew",
+		"srs-dom-rgp-restore-report",
+		"srs-dom-rgp-restore-request",
+		"srs-dom-transfer-approve",
+		"srs-dom-transfer-cancel",
+		"srs-dom-transfer-query",
+		"srs-dom-transfer-reject",
+		"srs-dom-transfer-request",
+		"srs-dom-update",
+		"srs-host-check",
+		"srs-host-create",
+		"srs-host-delete",
+		"srs-host-info",
+		"srs-host-update",
+		"srs-cont-check",
+		"srs-cont-create",
+		"srs-cont-delete",
+		"srs-cont-info",
+		"srs-cont-transfer-approve",
+		"srs-cont-transfer-cancel",
+		"srs-cont-transfer-query",
+		"srs-cont-transfer-reject",
+		"srs-cont-transfer-request",
+		"srs-cont-update",
+	)...),
+	Rows: 1,
+}
+
+// SchemaFor returns the schema of a report type ("transactions" or "activity").
+func SchemaFor(reportType string) (*Schema, error) {
+	switch reportType {
+	case Transactions.Name:
+		return &Transactions, nil
+	case Activity.Name:
+		return &Activity, nil
+	}
+	return nil, ErrUnknownReportType
+}
+
+// counts returns Count columns with the given names.
+func counts(names ...string) []Column {
+	cols := make([]Column, len(names))
+	for i, n := range names {
+		cols[i] = Column{n, Count}
+	}
+	return cols
+}
+
+// yearColumns returns the Count columns prefix-1-yr through prefix-10-yr.
+func yearColumns(prefix string) []Column {
+	cols := make([]Column, 10)
+	for i := range cols {
+		cols[i] = Column{fmt.Sprintf("%s-%d-yr", prefix, i+1), Count}
+	}
+	return cols
+}
@@ -0,0 +1,249 @@
+package reportcsv
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Error is a problem at a line and column of a report. Column is the 1-based
+// field index, or 0 for problems with a whole line or the file.
+type Error struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	switch {
+	case e.Field != "":
+		return fmt.Sprintf("line %d, column %d (%s): %s", e.Line, e.Column, e.Field, e.Message)
+	case e.Column > 0:
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Errors lists every problem found in a report, by line and column.
+type Errors []Error
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks the report read from r against s. It returns nil for a valid
+// report, Errors listing every problem found, or the error reading r.
+func Validate(s *Schema, r io.Reader) error {
+	v := &validator{schema: s, ianaIDs: make(map[uint64]int)}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	var rows [][]string
+	var lines []int
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			v.add(Error{Line: pe.Line, Message: pe.Err.Error()})
+			return v.errs
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+		rows = append(rows, rec)
+		lines = append(lines, line)
+	}
+	if len(rows) == 0 {
+		return Errors{{Line: 1, Message: "report is empty, expected a header line"}}
+	}
+	headerLine := lines[0]
+	if !v.header(rows[0], headerLine) {
+		// Values cannot be matched to columns without a valid header
+		return v.errs
+	}
+	rows, lines = rows[1:], lines[1:]
+
+	if s.TotalsRow {
+		if len(rows) == 0 || strings.TrimSpace(rows[len(rows)-1][0]) != TotalsLabel {
+			v.add(Error{Line: lastLine(lines, headerLine), Message: fmt.Sprintf("missing %q row at the end of the report", TotalsLabel)})
+		} else {
+			n := len(rows) - 1
+			v.data(rows[:n], lines[:n])
+			v.totals(rows[n], lines[n], rows[:n])
+			return v.result()
+		}
+	}
+	if s.Rows > 0 && len(rows) != s.Rows {
+		v.add(Error{Line: lastLine(lines, headerLine), Message: fmt.Sprintf("expected %d data line(s) after the header, got %d", s.Rows, len(rows))})
+	}
+	v.data(rows, lines)
+	return v.result()
+}
+
+// validator accumulates the problems found in a report.
+type validator struct {
+	schema  *Schema
+	errs    Errors
+	ianaIDs map[uint64]int // IANA ID to the line it was first seen on
+}
+
+func (v *validator) add(e Error) { v.errs = append(v.errs, e) }
+
+func (v *validator) result() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(v.errs, func(a, b Error) int {
+		return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+	return v.errs
+}
+
+// header checks the field names and order of the header line.
+func (v *validator) header(rec []string, line int) bool {
+	ok := true
+	for i, col := range v.schema.Columns {
+		if i >= len(rec) {
+			v.add(Error{Line: line, Column: i + 1, Message: fmt.Sprintf("missing header %q", col.Name)})
+			ok = false
+			continue
+		}
+		name := strings.TrimSpace(strings.TrimPrefix(rec[i], "\ufeff"))
+		if name != col.Name {
+			v.add(Error{Line: line, Column: i + 1, Message: fmt.Sprintf("header is %q, want %q", name, col.Name)})
+			ok = false
+		}
+	}
+	for i := len(v.schema.Columns); i < len(rec); i++ {
+		v.add(Error{Line: line, Column: i + 1, Message: fmt.Sprintf("unexpected header %q", rec[i])})
+		ok = false
+	}
+	return ok
+}
+
+// data checks the values of each data row.
+func (v *validator) data(rows [][]string, lines []int) {
+	for i, rec := range rows {
+		if !v.width(rec, lines[i]) {
+			continue
+		}
+		for j, col := range v.schema.Columns {
+			v.value(col, strings.TrimSpace(rec[j]), lines[i], j+1)
+		}
+	}
+}
+
+// width checks the number of fields of a row.
+func (v *validator) width(rec []string, line int) bool {
+	if len(rec) != len(v.schema.Columns) {
+		v.add(Error{Line: line, Message: fmt.Sprintf("got %d fields, want %d", len(rec), len(v.schema.Columns))})
+		return false
+	}
+	return true
+}
+
+// value checks a single field and returns its numeric value for count columns, 0 for
+// "CZDS" in CountOrCZDS columns.
+func (v *validator) value(col Column, val string, line, column int) (uint64, bool) {
+	fail := func(msg string) (uint64, bool) {
+		v.add(Error{Line: line, Column: column, Field: col.Name, Message: msg})
+		return 0, false
+	}
+	switch col.Kind {
+	case Text:
+		if val == "" {
+			return fail("must not be empty")
+		}
+		if val == TotalsLabel {
+			return fail(fmt.Sprintf("%q is only allowed in the last row", TotalsLabel))
+		}
+		return 0, true
+	case IANAID:
+		n, err := strconv.ParseUint(val, 10, 64)
+		if err != nil || n == 0 {
+			return fail(fmt.Sprintf("%q is not a valid IANA ID (positive integer)", val))
+		}
+		if first, dup := v.ianaIDs[n]; dup {
+			return fail(fmt.Sprintf("IANA ID %d already reported on line %d", n, first))
+		}
+		v.ianaIDs[n] = line
+		return n, true
+	case CountOrCZDS:
+		if val == "CZDS" {
+			return 0, true
+		}
+	}
+	n, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return fail(fmt.Sprintf("%q is not a non-negative integer", val))
+	}
+	return n, true
+}
+
+// totals checks the totals row against the sums of the registrar rows.
+func (v *validator) totals(rec []string, line int, rows [][]string) {
+	if !v.width(rec, line) {
+		return
+	}
+columns:
+	for j, col := range v.schema.Columns {
+		val := strings.TrimSpace(rec[j])
+		switch col.Kind {
+		case Text:
+			continue // the label
+		case IANAID:
+			if val != "" {
+				v.add(Error{Line: line, Column: j + 1, Field: col.Name, Message: fmt.Sprintf("must be empty in the %q row", TotalsLabel)})
+			}
+			continue
+		case CountOrCZDS:
+			if val == "CZDS" {
+				continue // no count to check
+			}
+		}
+		total, ok := v.value(col, val, line, j+1)
+		if !ok {
+			continue
+		}
+		var sum uint64
+		for _, r := range rows {
+			if len(r) != len(v.schema.Columns) {
+				return // sums are meaningless with malformed rows
+			}
+			cell := strings.TrimSpace(r[j])
+			if col.Kind == CountOrCZDS && cell == "CZDS" {
+				continue // provided through CZDS, counts nothing
+			}
+			n, err := strconv.ParseUint(cell, 10, 64)
+			if err != nil {
+				continue columns // already reported for the registrar row
+			}
+			sum += n
+		}
+		if total != sum {
+			v.add(Error{Line: line, Column: j + 1, Field: col.Name, Message: fmt.Sprintf("total is %d, but the registrar rows sum to %d", total, sum)})
+		}
+	}
+}
+
+// lastLine returns the last line number in lines, or header if there are none.
+func lastLine(lines []int, header int) int {
+	if len(lines) > 0 {
+		return lines[len(lines)-1]
+	}
+	return header
+}
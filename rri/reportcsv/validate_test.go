@@ -0,0 +1,128 @@
+package reportcsv
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// row joins a name, an IANA ID and the same count for every Count column of s.
+func row(s *Schema, name, ianaID string, count int) string {
+	fields := []string{name, ianaID}
+	for range s.Columns[2:] {
+		fields = append(fields, strconv.Itoa(count))
+	}
+	return strings.Join(fields, ",")
+}
+
+func header(s *Schema) string {
+	names := make([]string, len(s.Columns))
+	for i, c := range s.Columns {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ",")
+}
+
+func validTransactions() []string {
+	s := &Transactions
+	return []string{header(s), row(s, "Registrar A", "1001", 3), row(s, `"Registrar B, Inc."`, "1002", 4), row(s, "Totals", "", 7)}
+}
+
+func errorsOf(t *testing.T, err error) Errors {
+	t.Helper()
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate = %v, want Errors", err)
+	}
+	return errs
+}
+
+func TestValidate_Transactions(t *testing.T) {
+	if len(Transactions.Columns) != 39 {
+		t.Fatalf("transactions report has %d columns, want 39", len(Transactions.Columns))
+	}
+	if err := Validate(&Transactions, strings.NewReader(strings.Join(validTransactions(), "\r\n")+"\r\n")); err != nil {
+		t.Fatalf("Validate(valid) = %v", err)
+	}
+
+	lines := validTransactions()
+	lines[2] = strings.Replace(lines[2], "1002,4,", "1001,x,", 1)
+	lines[3] = strings.Replace(lines[3], "Totals,,7,7,", "Totals,,7,9,", 1)
+	errs := errorsOf(t, Validate(&Transactions, strings.NewReader(strings.Join(lines, "\n"))))
+	want := []Error{
+		{Line: 3, Column: 2, Field: "iana-id", Message: "IANA ID 1001 already reported on line 2"},
+		{Line: 3, Column: 3, Field: "total-domains", Message: `"x" is not a non-negative integer`},
+		{Line: 4, Column: 4, Field: "total-nameservers", Message: "total is 9, but the registrar rows sum to 7"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("errors = %v, want %v", errs, want)
+	}
+	for i := range want {
+		if errs[i] != want[i] {
+			t.Fatalf("error %d = %+v, want %+v", i, errs[i], want[i])
+		}
+	}
+}
+
+func TestValidate_TransactionsLayout(t *testing.T) {
+	tests := []struct {
+		name  string
+		edit  func(lines []string) []string
+		wantL int
+		want  string
+	}{
+		{"swapped headers", func(l []string) []string {
+			l[0] = strings.Replace(l[0], "registrar-name,iana-id", "iana-id,registrar-name", 1)
+			return l
+		}, 1, `line 1, column 1: header is "iana-id", want "registrar-name"`},
+		{"missing totals", func(l []string) []string { return l[:3] }, 3, `line 3: missing "Totals" row at the end of the report`},
+		{"totals not last", func(l []string) []string { return []string{l[0], l[3], l[1]} }, 2, `line 2, column 1 (registrar-name): "Totals" is only allowed in the last row`},
+		{"short row", func(l []string) []string { l[1] = "Registrar A,1001,3"; return l }, 2, "line 2: got 3 fields, want 39"},
+		{"iana id in totals", func(l []string) []string { l[3] = strings.Replace(l[3], "Totals,,", "Totals,0,", 1); return l }, 4, `line 4, column 2 (iana-id): must be empty in the "Totals" row`},
+		{"bad quote", func(l []string) []string { l[1] = `Registrar "A,1001`; return l }, 2, `line 2: bare " in non-quoted-field`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := tt.edit(validTransactions())
+			errs := errorsOf(t, Validate(&Transactions, strings.NewReader(strings.Join(lines, "\n"))))
+			if errs[0].Line != tt.wantL || errs[0].Error() != tt.want {
+				t.Fatalf("first error = %q (line %d), want %q", errs[0].Error(), errs[0].Line, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_Activity(t *testing.T) {
+	s := &Activity
+	values := make([]string, len(s.Columns))
+	for i := range values {
+		values[i] = "5"
+	}
+	values[1] = "CZDS"
+	valid := "\ufeff" + header(s) + "\n" + strings.Join(values, ",") + "\n"
+	if err := Validate(s, strings.NewReader(valid)); err != nil {
+		t.Fatalf("Validate(valid) = %v", err)
+	}
+
+	values[1], values[5] = "12", "-3"
+	errs := errorsOf(t, Validate(s, strings.NewReader(header(s)+"\n"+strings.Join(values, ",")+"\n"+strings.Join(values, ","))))
+	// Errors are sorted by line and column
+	if len(errs) != 3 || errs[0].Field != "dns-udp-queries-received" || errs[0].Line != 2 || errs[0].Column != 6 ||
+		errs[1].Error() != "line 3: expected 1 data line(s) after the header, got 2" {
+		t.Fatalf("errors = %v", errs)
+	}
+
+	if errs := errorsOf(t, Validate(s, strings.NewReader(""))); errs[0].Message != "report is empty, expected a header line" {
+		t.Fatalf("empty report: %v", errs)
+	}
+}
+
+func TestSchemaFor(t *testing.T) {
+	if s, err := SchemaFor("activity"); err != nil || s.Name != "activity" {
+		t.Fatalf("SchemaFor(activity) = %v, %v", s, err)
+	}
+	if _, err := SchemaFor("registrars"); !errors.Is(err, ErrUnknownReportType) {
+		t.Fatalf("SchemaFor(registrars) = %v, want ErrUnknownReportType", err)
+	}
+}